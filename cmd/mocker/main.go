@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Command mocker generates mocks for Go interfaces.
+//
+// It is a command-line front end for the
+// [github.com/ctx42/testing/pkg/mocker] package, meant to be used in
+// "//go:generate" directives without writing a generator program.
+//
+// Usage:
+//
+//	mocker [flags] Interface [Interface...]
+//	mocker -manifest file
+//	mocker -check [flags] Interface [Interface...]
+//	mocker -check -manifest file
+//
+// Example:
+//
+//	//go:generate go run github.com/ctx42/testing/cmd/mocker -src io Reader Writer
+//
+// The flags map onto the [mocker.Option] functions:
+//
+//	-src           [mocker.WithSrc]
+//	-tgt           [mocker.WithTgt]
+//	-name          [mocker.WithTgtName]
+//	-filename      [mocker.WithTgtFilename]
+//	-helpers       [mocker.WithTgtOnHelpers]
+//	-tester-alias  [mocker.WithTesterAlias]
+//
+// The -name and -filename flags can be used only when mocking a single
+// interface.
+//
+// With the -manifest flag, mocks listed in the YAML or JSON manifest file (see
+// [mocker.Manifest]) are generated in one run, and a summary line is printed
+// for each interface. No mock file is written if any of the interfaces cannot
+// be mocked.
+//
+// With the -check flag, mocks are generated in memory and compared with the
+// mock files on disk (see [mocker.Mocker.Check]). Nothing is written. The
+// unified diff is printed for each missing or out of date mock file, and the
+// command exits with a non-zero status code. Use it in CI to detect mocks
+// which need to be regenerated.
+//
+// On failure, the command prints the error to the standard error and exits
+// with a non-zero status code.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ctx42/testing/pkg/mocker"
+)
+
+// Exit codes.
+const (
+	exitOK    = 0 // Success.
+	exitError = 1 // Mock generation error or out of date mock.
+	exitUsage = 2 // Invalid command-line arguments.
+)
+
+func main() { os.Exit(run(os.Args[1:], os.Stdout, os.Stderr)) }
+
+// run runs the command with the given arguments and returns the exit code.
+// Manifest summary is written to "stdout", errors and usage to "stderr".
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("mocker", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "usage: mocker [flags] Interface [Interface...]")
+		_, _ = fmt.Fprintln(stderr, "       mocker -manifest file")
+		_, _ = fmt.Fprintln(stderr, "       mocker -check [flags] ...")
+		fs.PrintDefaults()
+	}
+
+	src := fs.String("src", "",
+		"source package `path`, dir or import path (default current package)")
+	tgt := fs.String("tgt", "",
+		"target package `path`, dir or import path (default current package)")
+	name := fs.String("name", "",
+		"mock type `name` (default <Interface>Mock)")
+	filename := fs.String("filename", "",
+		"mock `file` name (default <interface>_mock.go)")
+	helpers := fs.Bool("helpers", false,
+		"generate OnXXX helper methods")
+	alias := fs.String("tester-alias", "",
+		"tester package import `alias`")
+	manifest := fs.String("manifest", "",
+		"generate mocks listed in the YAML or JSON manifest `file`")
+	check := fs.Bool("check", false,
+		"check mock files are up to date without writing them")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	itfs := fs.Args()
+	if *manifest != "" {
+		if len(itfs) > 0 {
+			msg := "mocker: -manifest flag cannot be used with interface names"
+			_, _ = fmt.Fprintln(stderr, msg)
+			return exitUsage
+		}
+		return runManifest(*manifest, *check, stdout, stderr)
+	}
+	if len(itfs) == 0 {
+		_, _ = fmt.Fprintln(stderr, "mocker: interface name is required")
+		fs.Usage()
+		return exitUsage
+	}
+	if len(itfs) > 1 && (*name != "" || *filename != "") {
+		msg := "mocker: -name and -filename flags require a single interface"
+		_, _ = fmt.Fprintln(stderr, msg)
+		return exitUsage
+	}
+
+	opts := []mocker.Option{mocker.WithSrc(*src), mocker.WithTgt(*tgt)}
+	if *name != "" {
+		opts = append(opts, mocker.WithTgtName(*name))
+	}
+	if *filename != "" {
+		opts = append(opts, mocker.WithTgtFilename(*filename))
+	}
+	if *helpers {
+		opts = append(opts, mocker.WithTgtOnHelpers)
+	}
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "tester-alias" {
+			opts = append(opts, mocker.WithTesterAlias(*alias))
+		}
+	})
+
+	// Use the same instance to reuse the package cache.
+	mck := mocker.New()
+	if *check {
+		return runCheck(mck, itfs, opts, stderr)
+	}
+	for _, itf := range itfs {
+		if err := mck.Generate(itf, opts...); err != nil {
+			_, _ = fmt.Fprintf(stderr, "mocker: %s: %v\n", itf, err)
+			return exitError
+		}
+	}
+	return exitOK
+}
+
+// runCheck checks mocks for all interfaces are up to date and returns the exit
+// code. Errors, including diffs for out of date mocks, are written to
+// "stderr".
+func runCheck(
+	mck *mocker.Mocker,
+	itfs []string,
+	opts []mocker.Option,
+	stderr io.Writer,
+) int {
+
+	code := exitOK
+	for _, itf := range itfs {
+		if err := mck.Check(itf, opts...); err != nil {
+			_, _ = fmt.Fprintf(stderr, "mocker: %s: %v\n", itf, err)
+			code = exitError
+		}
+	}
+	return code
+}
+
+// runManifest generates or checks (when "check" is true) mocks listed in the
+// manifest file and returns the exit code. The summary is written to "stdout",
+// errors to "stderr".
+func runManifest(pth string, check bool, stdout, stderr io.Writer) int {
+	man, err := mocker.ReadManifest(pth)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "mocker: %v\n", err)
+		return exitError
+	}
+	var sums []mocker.Summary
+	if check {
+		sums, err = mocker.New().CheckManifest(man)
+	} else {
+		sums, err = mocker.New().GenerateManifest(man)
+	}
+	for _, sum := range sums {
+		_, _ = fmt.Fprintln(stdout, sum)
+	}
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "mocker: %v\n", err)
+		return exitError
+	}
+	return exitOK
+}
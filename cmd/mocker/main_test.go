@@ -0,0 +1,332 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/internal/tstmod"
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// cases is the import path of the mocker test cases package.
+const cases = "github.com/ctx42/testing/pkg/mocker/testdata/cases"
+
+func Test_run(t *testing.T) {
+	t.Run("single interface", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		stderr := &bytes.Buffer{}
+		args := []string{"-src", cases, "-tgt", mod.Dir, "Case00"}
+
+		// --- When ---
+		have := run(args, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitOK, have)
+		assert.Empty(t, stderr.String())
+		content := must.Value(os.ReadFile(mod.Path("case00_mock.go")))
+		assert.Contain(t, "type Case00Mock struct", string(content))
+	})
+
+	t.Run("multiple interfaces", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		stderr := &bytes.Buffer{}
+		args := []string{"-src", cases, "-tgt", mod.Dir, "Case00", "Case01"}
+
+		// --- When ---
+		have := run(args, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitOK, have)
+		assert.Empty(t, stderr.String())
+		content := must.Value(os.ReadFile(mod.Path("case00_mock.go")))
+		assert.Contain(t, "type Case00Mock struct", string(content))
+		content = must.Value(os.ReadFile(mod.Path("case01_mock.go")))
+		assert.Contain(t, "type Case01Mock struct", string(content))
+	})
+
+	t.Run("custom name and filename", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		stderr := &bytes.Buffer{}
+		args := []string{
+			"-src", cases,
+			"-tgt", mod.Dir,
+			"-name", "MyMock",
+			"-filename", "my.go",
+			"Case00",
+		}
+
+		// --- When ---
+		have := run(args, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitOK, have)
+		assert.Empty(t, stderr.String())
+		content := must.Value(os.ReadFile(mod.Path("my.go")))
+		assert.Contain(t, "type MyMock struct", string(content))
+	})
+
+	t.Run("on helpers and tester alias", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		stderr := &bytes.Buffer{}
+		args := []string{
+			"-src", cases,
+			"-tgt", mod.Dir,
+			"-helpers",
+			"-tester-alias", "tst",
+			"Case00",
+		}
+
+		// --- When ---
+		have := run(args, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitOK, have)
+		assert.Empty(t, stderr.String())
+		content := string(must.Value(os.ReadFile(mod.Path("case00_mock.go"))))
+		assert.Contain(t, "func (_mck *Case00Mock) OnMethod00()", content)
+		assert.Contain(t, `tst "github.com/ctx42/testing/pkg/tester"`, content)
+	})
+
+	t.Run("check up to date", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		args := []string{"-src", cases, "-tgt", mod.Dir, "Case00", "Case01"}
+		assert.Equal(t, exitOK, run(args, &bytes.Buffer{}, &bytes.Buffer{}))
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run(append([]string{"-check"}, args...), &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitOK, have)
+		assert.Empty(t, stderr.String())
+	})
+
+	t.Run("check out of date", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		args := []string{"-src", cases, "-tgt", mod.Dir, "Case00", "Case01"}
+		assert.Equal(t, exitOK, run(args, &bytes.Buffer{}, &bytes.Buffer{}))
+		pth := mod.WriteFile("case00_mock.go", "old\n")
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run(append([]string{"-check"}, args...), &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitError, have)
+		wMsg := "mocker: Case00: mock is out of date: " + pth
+		assert.Contain(t, wMsg, stderr.String())
+		assert.Contain(t, "\n-old\n", stderr.String())
+		assert.NotContain(t, "Case01", stderr.String())
+		assert.FileContain(t, "old", pth)
+	})
+
+	t.Run("check manifest", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		pth := mod.WriteFile("mocks.yaml", ""+
+			"mocks:\n"+
+			"  - src: "+cases+"\n"+
+			"    tgt: .\n"+
+			"    interfaces: [Case00, Case01]\n",
+		)
+		assert.Equal(t, exitOK, run(
+			[]string{"-manifest", pth}, &bytes.Buffer{}, &bytes.Buffer{},
+		))
+		must.Nil(os.Remove(mod.Path("case01_mock.go")))
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-check", "-manifest", pth}, stdout, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitError, have)
+		want := "" +
+			"ok   Case00: Case00Mock -> " + mod.Path("case00_mock.go") + "\n" +
+			"FAIL Case01: mock is out of date: " + mod.Path("case01_mock.go") +
+			" does not exist\n"
+		assert.Equal(t, want, stdout.String())
+		assert.Contain(t, "mocker: Case01: mock is out of date", stderr.String())
+		assert.NoFileExist(t, mod.Path("case01_mock.go"))
+	})
+
+	t.Run("help", func(t *testing.T) {
+		// --- Given ---
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-h"}, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitOK, have)
+		assert.Contain(t, "usage: mocker [flags] Interface", stderr.String())
+	})
+
+	t.Run("error - unknown flag", func(t *testing.T) {
+		// --- Given ---
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-unknown", "Case00"}, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitUsage, have)
+		assert.Contain(t, "flag provided but not defined: -unknown", stderr.String())
+	})
+
+	t.Run("error - no interface", func(t *testing.T) {
+		// --- Given ---
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-src", cases}, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitUsage, have)
+		assert.Contain(t, "mocker: interface name is required", stderr.String())
+	})
+
+	t.Run("error - name with multiple interfaces", func(t *testing.T) {
+		// --- Given ---
+		stderr := &bytes.Buffer{}
+		args := []string{"-src", cases, "-name", "MyMock", "Case00", "Case01"}
+
+		// --- When ---
+		have := run(args, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		want := "mocker: -name and -filename flags require a single interface\n"
+		assert.Equal(t, exitUsage, have)
+		assert.Equal(t, want, stderr.String())
+	})
+
+	t.Run("error - filename with multiple interfaces", func(t *testing.T) {
+		// --- Given ---
+		stderr := &bytes.Buffer{}
+		args := []string{"-src", cases, "-filename", "my.go", "Case00", "Case01"}
+
+		// --- When ---
+		have := run(args, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		want := "mocker: -name and -filename flags require a single interface\n"
+		assert.Equal(t, exitUsage, have)
+		assert.Equal(t, want, stderr.String())
+	})
+
+	t.Run("error - not an interface", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		stderr := &bytes.Buffer{}
+		args := []string{"-src", cases, "-tgt", mod.Dir, "Concrete"}
+
+		// --- When ---
+		have := run(args, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitError, have)
+		assert.Contain(t, "mocker: Concrete: interface not found", stderr.String())
+	})
+
+	t.Run("error - interface without methods", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		stderr := &bytes.Buffer{}
+		args := []string{"-src", cases, "-tgt", mod.Dir, "Case00", "Empty"}
+
+		// --- When ---
+		have := run(args, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		want := "mocker: Empty: interface has no methods\n"
+		assert.Equal(t, exitError, have)
+		assert.Equal(t, want, stderr.String())
+	})
+
+	t.Run("manifest", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		pth := mod.WriteFile("mocks.yaml", ""+
+			"mocks:\n"+
+			"  - src: "+cases+"\n"+
+			"    tgt: .\n"+
+			"    interfaces: [Case00, Case01]\n",
+		)
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-manifest", pth}, stdout, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitOK, have)
+		assert.Empty(t, stderr.String())
+		want := "" +
+			"ok   Case00: Case00Mock -> " + mod.Path("case00_mock.go") + "\n" +
+			"ok   Case01: Case01Mock -> " + mod.Path("case01_mock.go") + "\n"
+		assert.Equal(t, want, stdout.String())
+		assert.FileExist(t, mod.Path("case00_mock.go"))
+		assert.FileExist(t, mod.Path("case01_mock.go"))
+	})
+
+	t.Run("error - manifest interface not found", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		pth := mod.WriteFile("mocks.yaml", ""+
+			"mocks:\n"+
+			"  - src: "+cases+"\n"+
+			"    tgt: .\n"+
+			"    interfaces: [Case00, Unknown]\n",
+		)
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-manifest", pth}, stdout, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitError, have)
+		assert.Contain(t, "FAIL Unknown: type not found: Unknown", stdout.String())
+		assert.Contain(t, "mocker: Unknown: type not found", stderr.String())
+		assert.NoFileExist(t, mod.Path("case00_mock.go"))
+	})
+
+	t.Run("error - invalid manifest", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		pth := mod.WriteFile("mocks.yaml", "mocks: []\n")
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-manifest", pth}, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, exitError, have)
+		assert.Equal(t, "mocker: invalid manifest: no mocks\n", stderr.String())
+	})
+
+	t.Run("error - manifest with interface names", func(t *testing.T) {
+		// --- Given ---
+		stderr := &bytes.Buffer{}
+		args := []string{"-manifest", "mocks.yaml", "Case00"}
+
+		// --- When ---
+		have := run(args, &bytes.Buffer{}, stderr)
+
+		// --- Then ---
+		want := "mocker: -manifest flag cannot be used with interface names\n"
+		assert.Equal(t, exitUsage, have)
+		assert.Equal(t, want, stderr.String())
+	})
+}
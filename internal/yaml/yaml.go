@@ -0,0 +1,691 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Package yaml provides a minimal, dependency-free YAML parser.
+//
+// It supports the subset of YAML used in configuration files and test
+// fixtures: block mappings and sequences, flow mappings and sequences, plain,
+// single-quoted and double-quoted scalars, literal (|) and folded (>) block
+// scalars, and comments. Anchors, aliases, tags, and multiple documents are
+// not supported.
+//
+// Parsed documents are represented the same way [encoding/json] represents
+// them when decoding into an interface value: map[string]any, []any, string,
+// float64, bool, and nil.
+package yaml
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrSyntax is returned when the YAML document is invalid or uses features
+// which are not supported.
+var ErrSyntax = errors.New("yaml: syntax error")
+
+// line represents a line of the YAML document.
+type line struct {
+	num    int    // Line number (one based).
+	indent int    // Number of leading spaces.
+	raw    string // Line without the trailing new line.
+	text   string // Line without indentation and comments.
+}
+
+// blank returns true if the line has no content.
+func (l line) blank() bool { return l.text == "" }
+
+// Parse parses the YAML document. An empty document is parsed as nil.
+func Parse(data []byte) (any, error) {
+	lns, err := split(string(data))
+	if err != nil {
+		return nil, err
+	}
+	prs := &parser{lines: lns}
+	prs.skip()
+	if prs.eof() {
+		return nil, nil
+	}
+	val, err := prs.node(prs.cur().indent)
+	if err != nil {
+		return nil, err
+	}
+	if prs.skip(); !prs.eof() {
+		return nil, prs.errorf("unexpected content")
+	}
+	return val, nil
+}
+
+// split splits the document into lines, strips comments and validates
+// indentation.
+func split(doc string) ([]line, error) {
+	doc = strings.ReplaceAll(doc, "\r\n", "\n")
+	raws := strings.Split(doc, "\n")
+	lns := make([]line, 0, len(raws))
+	var started bool
+	for i, raw := range raws {
+		trimmed := strings.TrimLeft(raw, " ")
+		indent := len(raw) - len(trimmed)
+		text := strings.TrimSpace(stripComment(trimmed))
+		if strings.HasPrefix(trimmed, "\t") && text != "" {
+			return nil, fmt.Errorf("%w: line %d: tab indentation", ErrSyntax, i+1)
+		}
+		if indent == 0 && (text == "---" || strings.HasPrefix(text, "--- ")) {
+			if started {
+				format := "%w: line %d: multiple documents"
+				return nil, fmt.Errorf(format, ErrSyntax, i+1)
+			}
+			text = strings.TrimSpace(strings.TrimPrefix(text, "---"))
+		}
+		if indent == 0 && text == "..." {
+			text = ""
+		}
+		if strings.HasPrefix(text, "%") && !started {
+			text = "" // Directive.
+		}
+		started = started || text != ""
+		lns = append(lns, line{num: i + 1, indent: indent, raw: raw, text: text})
+	}
+	return lns, nil
+}
+
+// stripComment removes the comment from the line.
+func stripComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote == '\'' && c == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 || strings.ContainsRune(" \t[{,:-?", rune(s[i-1])) {
+				quote = c
+			}
+		case c == '#':
+			if i == 0 || s[i-1] == ' ' || s[i-1] == '\t' {
+				return s[:i]
+			}
+		}
+	}
+	return s
+}
+
+// parser represents the YAML parser state.
+type parser struct {
+	lines []line // Document lines.
+	pos   int    // Current line index.
+}
+
+// eof returns true if there are no more lines to parse.
+func (prs *parser) eof() bool { return prs.pos >= len(prs.lines) }
+
+// cur returns the current line.
+func (prs *parser) cur() line { return prs.lines[prs.pos] }
+
+// skip skips the blank lines.
+func (prs *parser) skip() {
+	for !prs.eof() && prs.cur().blank() {
+		prs.pos++
+	}
+}
+
+// errorf returns [ErrSyntax] error for the current line.
+func (prs *parser) errorf(format string, args ...any) error {
+	num := 0
+	if !prs.eof() {
+		num = prs.cur().num
+	} else if len(prs.lines) > 0 {
+		num = prs.lines[len(prs.lines)-1].num
+	}
+	msg := fmt.Sprintf(format, args...)
+	return fmt.Errorf("%w: line %d: %s", ErrSyntax, num, msg)
+}
+
+// node parses the block node starting at the current line with the given
+// indentation.
+func (prs *parser) node(indent int) (any, error) {
+	l := prs.cur()
+	if isSeqItem(l.text) {
+		return prs.sequence(indent)
+	}
+	if _, _, ok := splitKey(l.text); ok {
+		return prs.mapping(indent)
+	}
+	return prs.inline(indent)
+}
+
+// sequence parses the block sequence with the given indentation.
+func (prs *parser) sequence(indent int) ([]any, error) {
+	seq := make([]any, 0)
+	for prs.skip(); !prs.eof(); prs.skip() {
+		l := prs.cur()
+		if l.indent < indent || (l.indent == indent && !isSeqItem(l.text)) {
+			break
+		}
+		if l.indent > indent || !isSeqItem(l.text) {
+			return nil, prs.errorf("bad sequence indentation")
+		}
+
+		rest := strings.TrimLeft(l.text[1:], " ")
+		if rest == "" {
+			prs.pos++
+			val, err := prs.nested(indent, false)
+			if err != nil {
+				return nil, err
+			}
+			seq = append(seq, val)
+			continue
+		}
+
+		// Replace the current line with the item content, so it can be
+		// parsed as a node indented to the item content column. Block
+		// scalar content must be indented more than the item.
+		col := l.indent + len(l.text) - len(rest)
+		prs.lines[prs.pos] = line{num: l.num, indent: col, raw: l.raw, text: rest}
+		var val any
+		var err error
+		if rest[0] == '|' || rest[0] == '>' {
+			val, err = prs.block(indent)
+		} else {
+			val, err = prs.node(col)
+		}
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, val)
+	}
+	return seq, nil
+}
+
+// mapping parses the block mapping with the given indentation.
+func (prs *parser) mapping(indent int) (map[string]any, error) {
+	m := make(map[string]any)
+	for prs.skip(); !prs.eof(); prs.skip() {
+		l := prs.cur()
+		if l.indent < indent || (l.indent == indent && isSeqItem(l.text)) {
+			break
+		}
+		if l.indent > indent {
+			return nil, prs.errorf("bad mapping indentation")
+		}
+		key, rest, ok := splitKey(l.text)
+		if !ok {
+			return nil, prs.errorf("expected mapping key")
+		}
+		if _, dup := m[key]; dup {
+			return nil, prs.errorf("duplicate key %q", key)
+		}
+
+		if rest == "" {
+			prs.pos++
+			val, err := prs.nested(indent, true)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = val
+			continue
+		}
+
+		prs.lines[prs.pos].text = rest
+		val, err := prs.inline(indent)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = val
+	}
+	return m, nil
+}
+
+// nested parses the node starting on the line following a sequence item or a
+// mapping key with the given indentation. Sequences used as mapping values
+// may have the same indentation as the mapping key.
+func (prs *parser) nested(indent int, seqSame bool) (any, error) {
+	if prs.skip(); prs.eof() {
+		return nil, nil
+	}
+	l := prs.cur()
+	if l.indent > indent {
+		return prs.node(l.indent)
+	}
+	if seqSame && l.indent == indent && isSeqItem(l.text) {
+		return prs.sequence(indent)
+	}
+	return nil, nil
+}
+
+// inline parses the value which starts on the current line: a block scalar,
+// a flow collection, or a scalar. The indent is the indentation of the parent
+// node.
+func (prs *parser) inline(indent int) (any, error) {
+	text := prs.cur().text
+	switch text[0] {
+	case '|', '>':
+		return prs.block(indent)
+
+	case '[', '{':
+		// Flow collections may span multiple lines.
+		for !balanced(text) {
+			if prs.pos++; prs.eof() {
+				return nil, prs.errorf("unterminated flow collection")
+			}
+			text += " " + prs.cur().text
+		}
+		prs.pos++
+		fp := &flow{s: text}
+		val, err := fp.value()
+		if err != nil {
+			return nil, prs.errorf("%s", err)
+		}
+		if fp.ws(); fp.i < len(fp.s) {
+			return nil, prs.errorf("unexpected flow content")
+		}
+		return val, nil
+
+	case '&', '*', '!':
+		return nil, prs.errorf("anchors, aliases and tags are not supported")
+	}
+
+	val, err := scalar(text)
+	if err != nil {
+		return nil, prs.errorf("%s", err)
+	}
+	prs.pos++
+	return val, nil
+}
+
+// block parses the literal or folded block scalar. The indent is the
+// indentation of the parent node.
+func (prs *parser) block(indent int) (string, error) {
+	header := prs.cur().text
+	folded := header[0] == '>'
+	chomp := byte(0)
+	for _, c := range []byte(header[1:]) {
+		switch {
+		case c == '-' || c == '+':
+			chomp = c
+		case c >= '1' && c <= '9':
+			// Explicit indentation indicators are detected automatically.
+		default:
+			return "", prs.errorf("invalid block scalar header")
+		}
+	}
+	prs.pos++
+
+	// Collect raw lines which are blank or more indented than the parent.
+	var raws []string
+	content := -1
+	for ; !prs.eof(); prs.pos++ {
+		l := prs.cur()
+		if strings.TrimSpace(l.raw) == "" {
+			raws = append(raws, "")
+			continue
+		}
+		if l.indent <= indent {
+			break
+		}
+		if content == -1 {
+			content = l.indent
+		}
+		if l.indent < content {
+			return "", prs.errorf("bad block scalar indentation")
+		}
+		raws = append(raws, l.raw[content:])
+	}
+
+	// Trailing blank lines are subject to chomping.
+	trail := 0
+	for len(raws) > 0 && raws[len(raws)-1] == "" {
+		raws = raws[:len(raws)-1]
+		trail++
+	}
+	if len(raws) == 0 {
+		return "", nil
+	}
+
+	var val string
+	if folded {
+		val = fold(raws)
+	} else {
+		val = strings.Join(raws, "\n")
+	}
+	switch chomp {
+	case '-':
+	case '+':
+		val += "\n" + strings.Repeat("\n", trail)
+	default:
+		val += "\n"
+	}
+	return val, nil
+}
+
+// fold folds the block scalar lines: lines are joined with spaces, empty
+// lines become new lines, and more indented lines are kept as is.
+func fold(raws []string) string {
+	var sb strings.Builder
+	for i, raw := range raws {
+		if i > 0 {
+			prev := raws[i-1]
+			switch {
+			case raw == "":
+				sb.WriteByte('\n')
+				continue
+			case prev == "":
+				// The new line was written for the empty line.
+			case strings.HasPrefix(raw, " ") || strings.HasPrefix(prev, " "):
+				sb.WriteByte('\n')
+			default:
+				sb.WriteByte(' ')
+			}
+		}
+		sb.WriteString(raw)
+	}
+	return sb.String()
+}
+
+// isSeqItem returns true if the text is a block sequence item.
+func isSeqItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// splitKey splits the block mapping entry into the key and the rest of the
+// line. Returns false if the text is not a mapping entry.
+func splitKey(text string) (string, string, bool) {
+	if text == "" {
+		return "", "", false
+	}
+	if text[0] == '"' || text[0] == '\'' {
+		key, n, err := quoted(text)
+		if err != nil {
+			return "", "", false
+		}
+		rest := strings.TrimLeft(text[n:], " ")
+		if !strings.HasPrefix(rest, ":") {
+			return "", "", false
+		}
+		rest = rest[1:]
+		if rest != "" && rest[0] != ' ' {
+			return "", "", false
+		}
+		return key, strings.TrimSpace(rest), true
+	}
+	if strings.ContainsRune("[{&*!|>'\"%@`", rune(text[0])) {
+		return "", "", false
+	}
+	for i := 0; i < len(text); i++ {
+		if text[i] == ':' && (i+1 == len(text) || text[i+1] == ' ') {
+			key := strings.TrimSpace(text[:i])
+			if key == "" {
+				return "", "", false
+			}
+			return key, strings.TrimSpace(text[i+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+// balanced returns true if all flow collection brackets in the text are
+// closed.
+func balanced(s string) bool {
+	var depth int
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote == '\'' && c == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		}
+	}
+	return depth <= 0
+}
+
+// scalar parses a single-line scalar.
+func scalar(text string) (any, error) {
+	if text[0] == '"' || text[0] == '\'' {
+		val, n, err := quoted(text)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(text[n:]) != "" {
+			return nil, errors.New("unexpected content after quoted scalar")
+		}
+		return val, nil
+	}
+	return resolve(text), nil
+}
+
+// resolve resolves the plain scalar to its value.
+func resolve(s string) any {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return nil
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	case ".inf", ".Inf", ".INF", "+.inf", "+.Inf", "+.INF":
+		return math.Inf(1)
+	case "-.inf", "-.Inf", "-.INF":
+		return math.Inf(-1)
+	case ".nan", ".NaN", ".NAN":
+		return math.NaN()
+	}
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0o") {
+		if i, err := strconv.ParseInt(s, 0, 64); err == nil {
+			if !strings.Contains(s, "_") {
+				return float64(i)
+			}
+		}
+		return s
+	}
+	if strings.Trim(s, "0123456789.eE+-") != "" {
+		return s
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return s
+}
+
+// quoted parses the quoted scalar at the beginning of the text. Returns the
+// scalar value and the number of bytes consumed.
+func quoted(text string) (string, int, error) {
+	q := text[0]
+	var sb strings.Builder
+	for i := 1; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c == q && q == '\'' && i+1 < len(text) && text[i+1] == '\'':
+			sb.WriteByte('\'')
+			i++
+		case c == q:
+			return sb.String(), i + 1, nil
+		case c == '\\' && q == '"':
+			n, err := escape(&sb, text[i+1:])
+			if err != nil {
+				return "", 0, err
+			}
+			i += n
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", 0, errors.New("unterminated quoted scalar")
+}
+
+// escapes maps the double-quoted scalar single character escape sequences to
+// the characters they represent.
+var escapes = map[byte]string{
+	'0': "\x00", 'a': "\a", 'b': "\b", 't': "\t", '\t': "\t", 'n': "\n",
+	'v': "\v", 'f': "\f", 'r': "\r", 'e': "\x1b", ' ': " ", '"': "\"",
+	'/': "/", '\\': "\\", 'N': "\u0085", '_': "\u00a0",
+}
+
+// escape writes the unescaped character from the double-quoted scalar escape
+// sequence (without the leading backslash). Returns the number of bytes
+// consumed.
+func escape(sb *strings.Builder, s string) (int, error) {
+	if s == "" {
+		return 0, errors.New("invalid escape sequence")
+	}
+	if r, ok := escapes[s[0]]; ok {
+		sb.WriteString(r)
+		return 1, nil
+	}
+	size := map[byte]int{'x': 2, 'u': 4, 'U': 8}[s[0]]
+	if size == 0 || len(s) < size+1 {
+		return 0, errors.New("invalid escape sequence")
+	}
+	code, err := strconv.ParseUint(s[1:size+1], 16, 32)
+	if err != nil {
+		return 0, errors.New("invalid escape sequence")
+	}
+	sb.WriteRune(rune(code))
+	return size + 1, nil
+}
+
+// flow represents the flow collection parser state.
+type flow struct {
+	s string // Flow collection text.
+	i int    // Current position.
+}
+
+// ws skips the white space.
+func (fp *flow) ws() {
+	for fp.i < len(fp.s) && fp.s[fp.i] == ' ' {
+		fp.i++
+	}
+}
+
+// value parses the flow node at the current position.
+func (fp *flow) value() (any, error) {
+	if fp.ws(); fp.i >= len(fp.s) {
+		return nil, errors.New("unexpected end of flow collection")
+	}
+	switch fp.s[fp.i] {
+	case '[':
+		return fp.sequence()
+	case '{':
+		return fp.mapping()
+	case '"', '\'':
+		val, n, err := quoted(fp.s[fp.i:])
+		if err != nil {
+			return nil, err
+		}
+		fp.i += n
+		return val, nil
+	case '&', '*', '!':
+		return nil, errors.New("anchors, aliases and tags are not supported")
+	}
+	return resolve(fp.plain()), nil
+}
+
+// plain returns the plain scalar at the current position.
+func (fp *flow) plain() string {
+	start := fp.i
+	for ; fp.i < len(fp.s); fp.i++ {
+		c := fp.s[fp.i]
+		if c == ',' || c == ']' || c == '}' {
+			break
+		}
+		if c == ':' && (fp.i+1 == len(fp.s) || isFlowSep(fp.s[fp.i+1])) {
+			break
+		}
+	}
+	return strings.TrimSpace(fp.s[start:fp.i])
+}
+
+// isFlowSep returns true if the character ends a flow mapping key.
+func isFlowSep(c byte) bool { return strings.IndexByte(" ,]}", c) >= 0 }
+
+// sequence parses the flow sequence at the current position.
+func (fp *flow) sequence() ([]any, error) {
+	fp.i++ // Skip '['.
+	seq := make([]any, 0)
+	for {
+		if fp.ws(); fp.i < len(fp.s) && fp.s[fp.i] == ']' {
+			fp.i++
+			return seq, nil
+		}
+		val, err := fp.value()
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, val)
+		if err = fp.next(']'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// mapping parses the flow mapping at the current position.
+func (fp *flow) mapping() (map[string]any, error) {
+	fp.i++ // Skip '{'.
+	m := make(map[string]any)
+	for {
+		if fp.ws(); fp.i < len(fp.s) && fp.s[fp.i] == '}' {
+			fp.i++
+			return m, nil
+		}
+		key, err := fp.value()
+		if err != nil {
+			return nil, err
+		}
+		keyStr, ok := key.(string)
+		if !ok {
+			keyStr = fmt.Sprint(key)
+			if key == nil {
+				keyStr = "null"
+			}
+		}
+		if _, dup := m[keyStr]; dup {
+			return nil, fmt.Errorf("duplicate key %q", keyStr)
+		}
+		var val any
+		if fp.ws(); fp.i < len(fp.s) && fp.s[fp.i] == ':' {
+			fp.i++
+			if val, err = fp.value(); err != nil {
+				return nil, err
+			}
+		}
+		m[keyStr] = val
+		if err = fp.next('}'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// next consumes the separator between flow collection entries. It does not
+// consume the closing bracket.
+func (fp *flow) next(closing byte) error {
+	if fp.ws(); fp.i >= len(fp.s) {
+		return errors.New("unterminated flow collection")
+	}
+	switch fp.s[fp.i] {
+	case ',':
+		fp.i++
+		return nil
+	case closing:
+		return nil
+	}
+	return fmt.Errorf("unexpected character %q in flow collection", fp.s[fp.i])
+}
@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package yaml
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_Parse_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		doc  string
+		want any
+	}{
+		{"empty", "", nil},
+		{"only comments", "# comment\n\n# comment", nil},
+		{"plain string", "abc", "abc"},
+		{"plain string with spaces", "abc def", "abc def"},
+		{"integer", "42", 42.0},
+		{"negative integer", "-42", -42.0},
+		{"hex integer", "0x1F", 31.0},
+		{"octal integer", "0o17", 15.0},
+		{"float", "1.5", 1.5},
+		{"float exponent", "1e3", 1000.0},
+		{"not a number", "1.2.3", "1.2.3"},
+		{"true", "true", true},
+		{"false", "False", false},
+		{"null", "null", nil},
+		{"tilde null", "~", nil},
+		{"double-quoted", `"a\tb\n\"c\" \u00e9"`, "a\tb\n\"c\" é"},
+		{"single-quoted", `'it''s #1'`, "it's #1"},
+		{"quoted number", `"42"`, "42"},
+		{"document start", "---\na: 1", map[string]any{"a": 1.0}},
+		{"document start with value", "--- abc", "abc"},
+		{"document end", "a: 1\n...", map[string]any{"a": 1.0}},
+		{"directive", "%YAML 1.2\n---\na: 1", map[string]any{"a": 1.0}},
+		{
+			"mapping",
+			"a: 1\nb: two\nc: true\nd:\n",
+			map[string]any{"a": 1.0, "b": "two", "c": true, "d": nil},
+		},
+		{
+			"mapping with comments",
+			"# doc\na: 1 # one\nb: 'x # y' # two\nc: a#b\n",
+			map[string]any{"a": 1.0, "b": "x # y", "c": "a#b"},
+		},
+		{
+			"mapping with quoted keys",
+			`"a b": 1` + "\n'c': 2",
+			map[string]any{"a b": 1.0, "c": 2.0},
+		},
+		{
+			"mapping value with colon",
+			"url: http://example.com:8080/path",
+			map[string]any{"url": "http://example.com:8080/path"},
+		},
+		{
+			"nested mapping",
+			"a:\n  b:\n    c: 1\n  d: 2\ne: 3",
+			map[string]any{
+				"a": map[string]any{"b": map[string]any{"c": 1.0}, "d": 2.0},
+				"e": 3.0,
+			},
+		},
+		{
+			"sequence",
+			"- 1\n- two\n-\n- true",
+			[]any{1.0, "two", nil, true},
+		},
+		{
+			"nested sequence",
+			"- - 1\n  - 2\n- - 3",
+			[]any{[]any{1.0, 2.0}, []any{3.0}},
+		},
+		{
+			"sequence of mappings",
+			"- a: 1\n  b: 2\n- a: 3\n-\n  a: 4",
+			[]any{
+				map[string]any{"a": 1.0, "b": 2.0},
+				map[string]any{"a": 3.0},
+				map[string]any{"a": 4.0},
+			},
+		},
+		{
+			"mapping with sequence",
+			"a:\n  - 1\n  - 2\nb: 3",
+			map[string]any{"a": []any{1.0, 2.0}, "b": 3.0},
+		},
+		{
+			"mapping with not indented sequence",
+			"a:\n- 1\n- 2\nb: 3",
+			map[string]any{"a": []any{1.0, 2.0}, "b": 3.0},
+		},
+		{
+			"flow sequence",
+			"[1, two, 'three', \"four\", [5], {a: 6}, ~]",
+			[]any{
+				1.0, "two", "three", "four", []any{5.0},
+				map[string]any{"a": 6.0}, nil,
+			},
+		},
+		{
+			"empty flow collections",
+			"a: []\nb: {}",
+			map[string]any{"a": []any{}, "b": map[string]any{}},
+		},
+		{
+			"flow mapping",
+			"{a: 1, \"b\": [2, 3], c: http://x}",
+			map[string]any{"a": 1.0, "b": []any{2.0, 3.0}, "c": "http://x"},
+		},
+		{
+			"multi-line flow collection",
+			"a: [\n  1,\n  2\n]",
+			map[string]any{"a": []any{1.0, 2.0}},
+		},
+		{
+			"JSON document",
+			"{\n  \"a\": {\"b\": [1, 2.5, null, true]},\n  \"c\": \"d\"\n}",
+			map[string]any{
+				"a": map[string]any{"b": []any{1.0, 2.5, nil, true}},
+				"c": "d",
+			},
+		},
+		{
+			"literal block scalar",
+			"a: |\n  line 1\n\n    line 2\nb: 1",
+			map[string]any{"a": "line 1\n\n  line 2\n", "b": 1.0},
+		},
+		{
+			"literal block scalar strip",
+			"a: |-\n  line 1\n  line 2\n\n",
+			map[string]any{"a": "line 1\nline 2"},
+		},
+		{
+			"literal block scalar keep",
+			"a: |+\n  line 1\n\nb: 1",
+			map[string]any{"a": "line 1\n\n", "b": 1.0},
+		},
+		{
+			"literal block scalar with comment character",
+			"a: |\n  # not a comment\n",
+			map[string]any{"a": "# not a comment\n"},
+		},
+		{
+			"folded block scalar",
+			"a: >\n  one\n  two\n\n  three\n",
+			map[string]any{"a": "one two\nthree\n"},
+		},
+		{
+			"block scalar in sequence",
+			"- |\n  line 1\n  line 2\n- 2",
+			[]any{"line 1\nline 2\n", 2.0},
+		},
+		{
+			"empty block scalar",
+			"a: |\nb: 1",
+			map[string]any{"a": "", "b": 1.0},
+		},
+		{
+			"windows line endings",
+			"a: 1\r\nb: 2\r\n",
+			map[string]any{"a": 1.0, "b": 2.0},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := Parse([]byte(tc.doc))
+
+			// --- Then ---
+			if err != nil {
+				t.Fatalf("expected no error:\n  have: %v", err)
+			}
+			if !reflect.DeepEqual(tc.want, have) {
+				format := "expected values to be equal:\n  want: %#v\n  have: %#v"
+				t.Errorf(format, tc.want, have)
+			}
+		})
+	}
+}
+
+func Test_Parse_special_floats(t *testing.T) {
+	t.Run("infinity", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse([]byte("[.inf, -.Inf]"))
+
+		// --- Then ---
+		if err != nil {
+			t.Fatalf("expected no error:\n  have: %v", err)
+		}
+		want := []any{math.Inf(1), math.Inf(-1)}
+		if !reflect.DeepEqual(want, have) {
+			format := "expected values to be equal:\n  want: %#v\n  have: %#v"
+			t.Errorf(format, want, have)
+		}
+	})
+
+	t.Run("not a number", func(t *testing.T) {
+		// --- When ---
+		have, err := Parse([]byte(".nan"))
+
+		// --- Then ---
+		if err != nil {
+			t.Fatalf("expected no error:\n  have: %v", err)
+		}
+		if f, ok := have.(float64); !ok || !math.IsNaN(f) {
+			t.Errorf("expected NaN:\n  have: %#v", have)
+		}
+	})
+}
+
+func Test_Parse_errors_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		doc  string
+		want string
+	}{
+		{"tab indentation", "a:\n\tb: 1", "line 2: tab indentation"},
+		{"multiple documents", "a: 1\n---\nb: 2", "line 2: multiple documents"},
+		{"duplicate key", "a: 1\na: 2", `line 2: duplicate key "a"`},
+		{"bad mapping indentation", "a: 1\n  b: 2", "line 2: bad mapping indentation"},
+		{"bad sequence indentation", "- 1\n  - 2", "line 2: bad sequence indentation"},
+		{"expected mapping key", "a: 1\nb", "line 2: expected mapping key"},
+		{"unexpected content", "- 1\na: 2", "line 2: unexpected content"},
+		{"anchor", "a: &x 1", "line 1: anchors, aliases and tags are not supported"},
+		{"alias", "a: *x", "line 1: anchors, aliases and tags are not supported"},
+		{"tag", "a: !!str 1", "line 1: anchors, aliases and tags are not supported"},
+		{"unterminated quote", `a: "abc`, "line 1: unterminated quoted scalar"},
+		{"content after quote", `a: "abc" def`, "line 1: unexpected content after quoted scalar"},
+		{"invalid escape", `a: "\q"`, "line 1: invalid escape sequence"},
+		{"unterminated flow", "a: [1, 2", "line 1: unterminated flow collection"},
+		{"bad flow separator", "a: [1, 2}", "line 1: unexpected character '}'"},
+		{"unexpected flow content", "a: [1] 2", "line 1: unexpected flow content"},
+		{"flow duplicate key", "{a: 1, a: 2}", `line 1: duplicate key "a"`},
+		{"invalid block header", "a: |x\n  b", "line 1: invalid block scalar header"},
+		{"bad block indentation", "a: |\n    b\n  c", "line 3: bad block scalar indentation"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := Parse([]byte(tc.doc))
+
+			// --- Then ---
+			if !errors.Is(err, ErrSyntax) {
+				t.Fatalf("expected ErrSyntax error:\n  have: %v", err)
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				format := "expected error to contain:\n  want: %q\n  have: %q"
+				t.Errorf(format, tc.want, err.Error())
+			}
+			if have != nil {
+				t.Errorf("expected nil value:\n  have: %#v", have)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package assert_test
 
 import (
 	"fmt"
+	"net"
 	"strings"
 	"time"
 
@@ -113,9 +114,22 @@ func ExampleJSON() {
 
 	fmt.Println(err)
 	// Output:
-	// expected JSON strings to be equal:
-	//   want: {"A":1,"B":2}
-	//   have: {"A":1,"B":3}
+	// multiple expectations violated:
+	//   error: expected JSON strings to be equal
+	//    diff:
+	//          --- want
+	//          +++ have
+	//          @@ -1,4 +1,4 @@
+	//           {
+	//             "A": 1,
+	//          -  "B": 2
+	//          +  "B": 3
+	//           }
+	//       ---
+	//   error: expected values to be equal
+	//   trail: /B
+	//    want: 2
+	//    have: 3
 }
 
 func ExampleEqual_listVisitedTrails() {
@@ -245,6 +259,152 @@ func ExampleEqual_skipAllUnexportedFields() {
 	// T.Next.Next.Next.Next
 }
 
+func ExampleEqual_unorderedSlices() {
+	type T struct {
+		Name  string
+		Roles []string
+	}
+
+	want := T{Name: "Bob", Roles: []string{"admin", "dev", "ops"}}
+	have := T{Name: "Bob", Roles: []string{"ops", "admin", "qa"}}
+
+	err := check.Equal(want, have, check.WithUnorderedTrail("T.Roles"))
+
+	fmt.Println(err)
+	// Output:
+	// expected slices to have the same elements:
+	//      trail: T.Roles
+	//   want len: 3
+	//   have len: 3
+	//    missing:
+	//             []string{
+	//               "dev",
+	//             }
+	//      extra:
+	//             []string{
+	//               "qa",
+	//             }
+}
+
+func ExampleEqual_sliceKey() {
+	type User struct {
+		ID   int
+		Name string
+	}
+
+	want := []User{{ID: 1, Name: "Bob"}, {ID: 2, Name: "Kim"}}
+	have := []User{{ID: 3, Name: "Tom"}, {ID: 1, Name: "Rob"}}
+
+	err := check.Equal(want, have, check.WithSliceKey("", func(e any) any {
+		return e.(User).ID
+	}))
+
+	fmt.Println(err)
+	// Output:
+	// multiple expectations violated:
+	//     error: expected slices to have the same elements by key
+	//   removed: 2
+	//     added: 3
+	//   changed: 1
+	//         ---
+	//     error: expected values to be equal
+	//     trail: <slice>[0].Name
+	//      want: "Bob"
+	//      have: "Rob"
+}
+
+func ExampleEqual_allDiffs() {
+	type T struct {
+		Name string
+		Tags []string
+	}
+
+	want := T{Name: "Bob", Tags: []string{"a", "b", "c"}}
+	have := T{Name: "Rob", Tags: []string{"a", "x"}}
+
+	err := check.Equal(want, have, check.WithAllDiffs())
+
+	fmt.Println(err)
+	// Output:
+	// multiple expectations violated:
+	//   error: expected values to be equal
+	//   trail: T.Name
+	//    want: "Bob"
+	//    have: "Rob"
+	//       ---
+	//   error: expected values to be equal
+	//   trail: T.Tags[1]
+	//    want: "b"
+	//    have: "x"
+	//       ---
+	//   error: expected slice element to exist
+	//   trail: T.Tags[2]
+	//    want: "c"
+}
+
+func ExampleEqual_floatDelta() {
+	type Point struct {
+		X, Y float64
+	}
+
+	want := []Point{{X: 1, Y: 2}, {X: 3, Y: 4}}
+	have := []Point{{X: 1.001, Y: 2}, {X: 3, Y: 4.5}}
+
+	err := check.Equal(want, have, check.WithFloatDelta(0.01))
+
+	fmt.Println(err)
+	// Output:
+	// expected numbers to be within the given delta:
+	//        trail: <slice>[1].Y
+	//         want: 4
+	//         have: 4.5
+	//   want delta: 0.01
+	//   have delta: 0.5
+}
+
+func ExampleEqual_equalMethods() {
+	type T struct {
+		Name string
+		Addr net.IP
+	}
+
+	// The same address in 16-byte and 4-byte representations.
+	want := T{Name: "srv", Addr: net.ParseIP("10.0.0.1")}
+	have := T{Name: "srv", Addr: net.IPv4(10, 0, 0, 1).To4()}
+
+	err := check.Equal(want, have, check.WithEqualMethods())
+
+	fmt.Println(err)
+	// Output:
+	// <nil>
+}
+
+func ExampleEqual_transform() {
+	type User struct {
+		Name  string
+		Email string
+	}
+
+	want := User{Name: "Bob", Email: "Bob@Example.com"}
+	have := User{Name: "Bob", Email: " bob@example.org "}
+
+	normalize := func(v any) any {
+		return strings.ToLower(strings.TrimSpace(v.(string)))
+	}
+	opt := check.WithTrailTransform("User.Email", normalize)
+
+	err := check.Equal(want, have, opt)
+
+	fmt.Println(err)
+	// Output:
+	// expected values to be equal:
+	//           trail: User.Email
+	//            want: "bob@example.com"
+	//            have: "bob@example.org"
+	//   want original: "Bob@Example.com"
+	//   have original: " bob@example.org "
+}
+
 func ExampleEqualFold() {
 	err := check.EqualFold("ABC", "abc") // Case-insensitive.
 
@@ -20,3 +20,37 @@ func JSON[W, H check.Text](t tester.T, want W, have H, opts ...any) bool {
 	}
 	return true
 }
+
+// JSONSubset asserts that the "want" JSON string is a subset of the "have"
+// JSON string. Every key and array element in "want" must be present and
+// equal in "have". Extra object keys in "have" are ignored.
+//
+// See [check.JSONSubset] for the error-returning form and the
+// [check.WithUnorderedArrays] option.
+func JSONSubset[W, H check.Text](t tester.T, want W, have H, opts ...any) bool {
+	t.Helper()
+	if e := check.JSONSubset(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
+
+// JSONSchema asserts that the JSON document is valid against the JSON Schema.
+//
+// See [check.JSONSchema] for the error-returning form and the list of
+// supported keywords.
+func JSONSchema[S, D check.Text](
+	t tester.T,
+	schema S,
+	doc D,
+	opts ...any,
+) bool {
+
+	t.Helper()
+	if e := check.JSONSchema(schema, doc, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
@@ -103,3 +103,91 @@ func Test_JSON(t *testing.T) {
 		affirm.Equal(t, false, got)
 	})
 }
+
+func Test_JSONSubset(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		want := `{"hello": "world"}`
+		have := `{"hello": "world", "id": 1}`
+
+		// --- When ---
+		got := JSONSubset(tspy, want, have)
+
+		// --- Then ---
+		affirm.Equal(t, true, got)
+	})
+
+	t.Run("success bytes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		want := []byte(`[2, 1]`)
+		have := []byte(`[1, 2, 3]`)
+		opt := check.WithUnorderedArrays()
+
+		// --- When ---
+		got := JSONSubset(tspy, want, have, opt)
+
+		// --- Then ---
+		affirm.Equal(t, true, got)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("expected JSON key to exist:\n" +
+			"  trail: type.field/id\n" +
+			"   want: 1")
+		tspy.Close()
+
+		want := `{"hello": "world", "id": 1}`
+		have := `{"hello": "world"}`
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		got := JSONSubset(tspy, want, have, opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, got)
+	})
+}
+
+func Test_JSONSchema(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		schema := `{"type": "object", "required": ["id"]}`
+		doc := []byte(`{"id": 1}`)
+
+		// --- When ---
+		got := JSONSchema(tspy, schema, doc)
+
+		// --- Then ---
+		affirm.Equal(t, true, got)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("expected JSON to match the schema:\n" +
+			"    trail: type.field/id\n" +
+			"  keyword: required\n" +
+			"   schema: #/required")
+		tspy.Close()
+
+		schema := `{"type": "object", "required": ["id"]}`
+		doc := `{}`
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		got := JSONSchema(tspy, schema, doc, opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, got)
+	})
+}
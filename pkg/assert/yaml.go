@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// YAML asserts that two YAML strings are equivalent.
+//
+// See [check.YAML] for the error-returning form and the supported YAML
+// subset.
+func YAML[W, H check.Text](t tester.T, want W, have H, opts ...any) bool {
+	t.Helper()
+	if e := check.YAML(want, have, opts...); e != nil {
+		t.Error(e)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_YAML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t).Close()
+
+		want := "a: 1\nb: [x, y]"
+		have := []byte("b:\n  - x\n  - y\na: 1")
+
+		// --- When ---
+		got := YAML(tspy, want, have)
+
+		// --- Then ---
+		affirm.Equal(t, true, got)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  trail: type.field/a\n")
+		tspy.Close()
+
+		want := "a: 1"
+		have := "a: 2"
+		opt := check.WithTrail("type.field")
+
+		// --- When ---
+		got := YAML(tspy, want, have, opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, got)
+	})
+}
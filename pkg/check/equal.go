@@ -5,9 +5,13 @@ package check
 
 import (
 	"fmt"
+	"maps"
+	"math/cmplx"
 	"reflect"
 	"slices"
 	"sort"
+	"strconv"
+	"strings"
 	"unsafe"
 
 	"github.com/ctx42/testing/internal/core"
@@ -27,7 +31,11 @@ func Equal(want, have any, opts ...any) error {
 	}
 	wVal := reflect.ValueOf(want)
 	hVal := reflect.ValueOf(have)
-	return deepEqual(wVal, hVal, make(map[visit]bool), WithOptions(ops))
+	err := deepEqual(wVal, hVal, make(map[visit]bool), WithOptions(ops))
+	if err != nil && ops.AllDiffs {
+		return limitDiffs(err, ops)
+	}
+	return err
 }
 
 // NotEqual checks that want and have are not equal.
@@ -70,6 +78,24 @@ func deepEqual(
 		return nil
 	}
 
+	// Compare transformed values (see [WithTransform]). The transformed values
+	// are not transformed again.
+	if !ops.transformed {
+		if ok, err := transformEqual(wVal, hVal, visited, ops); ok {
+			return err
+		}
+	}
+	ops.transformed = false
+
+	// Apply the float tolerances set for the trail to all nested values (see
+	// [WithFloatDeltaTrail] and [WithFloatEpsilonTrail]).
+	if delta, ok := ops.FloatDeltaTrails[ops.Trail]; ok {
+		ops.FloatDelta = delta
+	}
+	if epsilon, ok := ops.FloatEpsilonTrails[ops.Trail]; ok {
+		ops.FloatEpsilon = epsilon
+	}
+
 	// Skip unexported fields if the option is turned on
 	// (see [WithSkipUnexported]).
 	if wVal.IsValid() && !wVal.CanInterface() && ops.SkipUnexported {
@@ -159,6 +185,18 @@ func deepEqual(
 		return chk(wItf, hItf, WithOptions(ops))
 	}
 
+	// Use the equality methods defined on the type (see [WithEqualMethods]).
+	if ops.EqualMethods {
+		if name, equal := equalMethod(wVal, hVal); name != "" {
+			ops.Trail = strings.TrimSpace(ops.Trail + " <" + name + ">")
+			ops.LogTrail()
+			if equal {
+				return nil
+			}
+			return equalError(wVal.Interface(), hVal.Interface(), WithOptions(ops))
+		}
+	}
+
 	switch knd := wVal.Kind(); knd {
 	case reflect.Pointer:
 		// Recurse into the pointed-to values (nil == nil is already handled above).
@@ -188,8 +226,18 @@ func deepEqual(
 		return err
 
 	case reflect.Slice, reflect.Array:
+		// Align slice elements by keys (see [WithSliceKey]).
+		if fn := ops.SliceKeys[ops.Trail]; knd == reflect.Slice && fn != nil {
+			return keyedEqual(wVal, hVal, visited, ops, fn)
+		}
+
+		// Compare slices as multisets (see [WithUnorderedSlices]).
+		if knd == reflect.Slice && isUnordered(ops) {
+			return unorderedEqual(wVal, hVal, visited, ops)
+		}
+
 		// Lengths must match first. Then compare element-wise with index trails.
-		if wVal.Len() != hVal.Len() {
+		if wVal.Len() != hVal.Len() && !ops.AllDiffs {
 			ops.LogTrail()
 			wStr, hStr, diff := ops.Dumper.DiffValue(wVal, hVal)
 			msg := notice.New("expected values to be equal").
@@ -200,15 +248,30 @@ func deepEqual(
 				Append("diff", "%s", diff)
 			return AddRows(ops, msg)
 		}
-		if knd == reflect.Slice && wVal.Pointer() == hVal.Pointer() {
+		if wVal.Len() == hVal.Len() &&
+			knd == reflect.Slice && wVal.Pointer() == hVal.Pointer() {
 			ops.LogTrail()
 			return nil
 		}
 		var err error
-		for i := 0; i < wVal.Len(); i++ {
+		for i := 0; i < max(wVal.Len(), hVal.Len()); i++ {
+			iOps := ops.ArrTrail(knd.String(), i)
+			if i >= hVal.Len() {
+				iOps.LogTrail()
+				msg := notice.New("expected slice element to exist").
+					Want("%s", ops.Dumper.Value(wVal.Index(i)))
+				err = notice.Join(err, AddRows(iOps, msg))
+				continue
+			}
+			if i >= wVal.Len() {
+				iOps.LogTrail()
+				msg := notice.New("expected slice element not to exist").
+					Have("%s", ops.Dumper.Value(hVal.Index(i)))
+				err = notice.Join(err, AddRows(iOps, msg))
+				continue
+			}
 			wiVal := wVal.Index(i)
 			hiVal := hVal.Index(i)
-			iOps := ops.ArrTrail(knd.String(), i)
 			if e := deepEqual(wiVal, hiVal, visited, WithOptions(iOps)); e != nil {
 				err = notice.Join(err, e)
 			}
@@ -218,6 +281,9 @@ func deepEqual(
 	case reflect.Map:
 		// Lengths must match. Compare only keys present in "want" (extra keys in
 		// "have" are allowed). Keys are sorted for deterministic ordering.
+		if ops.AllDiffs {
+			return mapDiffs(wVal, hVal, visited, ops)
+		}
 		if wVal.Len() != hVal.Len() {
 			ops.LogTrail()
 			wStr, hStr, diff := ops.Dumper.DiffValue(wVal, hVal)
@@ -358,7 +424,7 @@ func deepEqual(
 		if w == h {
 			return nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return floatEqual(w, h, complex(float64(w), 0), complex(float64(h), 0), ops)
 
 	case reflect.Float64:
 		ops.LogTrail()
@@ -366,7 +432,7 @@ func deepEqual(
 		if w == h {
 			return nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return floatEqual(w, h, complex(w, 0), complex(h, 0), ops)
 
 	case reflect.Complex64:
 		ops.LogTrail()
@@ -374,7 +440,7 @@ func deepEqual(
 		if w == h {
 			return nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return floatEqual(w, h, complex128(w), complex128(h), ops)
 
 	case reflect.Complex128:
 		ops.LogTrail()
@@ -382,7 +448,7 @@ func deepEqual(
 		if w == h {
 			return nil
 		}
-		return equalError(w, h, WithOptions(ops))
+		return floatEqual(w, h, w, h, ops)
 
 	case reflect.String:
 		ops.LogTrail()
@@ -446,6 +512,426 @@ func deepEqual(
 	}
 }
 
+// mapDiffs compares maps key by key reporting all the differences, including
+// the keys missing in "have" and the extra keys in "have". See [WithAllDiffs].
+func mapDiffs(
+	wVal, hVal reflect.Value,
+	visited map[visit]bool,
+	ops Options,
+) error {
+
+	if wVal.Len() == hVal.Len() && wVal.Pointer() == hVal.Pointer() {
+		ops.LogTrail()
+		return nil
+	}
+
+	keys := wVal.MapKeys()
+	for _, key := range hVal.MapKeys() {
+		if !wVal.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return valToString(keys[i]) < valToString(keys[j])
+	})
+
+	var err error
+	for _, key := range keys {
+		wkVal := wVal.MapIndex(key)
+		hkVal := hVal.MapIndex(key)
+		kOps := ops.MapTrail(valToString(key))
+		switch {
+		case !hkVal.IsValid():
+			kOps.LogTrail()
+			msg := notice.New("expected map key to exist").
+				Want("%s", ops.Dumper.Value(wkVal))
+			err = notice.Join(err, AddRows(kOps, msg))
+
+		case !wkVal.IsValid():
+			kOps.LogTrail()
+			msg := notice.New("expected map key not to exist").
+				Have("%s", ops.Dumper.Value(hkVal))
+			err = notice.Join(err, AddRows(kOps, msg))
+
+		default:
+			e := deepEqual(wkVal, hkVal, visited, WithOptions(kOps))
+			if e != nil {
+				err = notice.Join(err, e)
+			}
+		}
+	}
+	return err
+}
+
+// limitDiffs sorts the differences by their trails and limits their number to
+// [Options.MaxDiffs]. See [WithAllDiffs].
+func limitDiffs(err error, ops Options) error {
+	tail := notice.SortNotices(notice.From(err).Head(), notice.TrailCmp)
+	all := tail.All()
+	if ops.MaxDiffs <= 0 || len(all) <= ops.MaxDiffs {
+		return tail
+	}
+	for _, msg := range all[ops.MaxDiffs:] {
+		_ = msg.Unchain()
+	}
+	msg := notice.New("too many differences to report").
+		Append("reported", "%d", ops.MaxDiffs).
+		Append("total", "%d", len(all))
+	return notice.Join(all[ops.MaxDiffs-1], AddRows(ops, msg))
+}
+
+// transformEqual compares values transformed by the functions registered with
+// [WithTransform] or [WithTrailTransform]. The trail transformer takes
+// precedence over the type transformer. Returns false when no transformer was
+// applied to any of the values.
+func transformEqual(
+	wVal, hVal reflect.Value,
+	visited map[visit]bool,
+	ops Options,
+) (bool, error) {
+
+	if !wVal.IsValid() || !hVal.IsValid() ||
+		!wVal.CanInterface() || !hVal.CanInterface() {
+		return false, nil
+	}
+	wItf, hItf := wVal.Interface(), hVal.Interface()
+	wTrn, hTrn := wItf, hItf
+	if fn := ops.TrailTransforms[ops.Trail]; fn != nil {
+		wTrn, hTrn = fn(wItf), fn(hItf)
+	} else {
+		wFn := ops.TypeTransforms[wVal.Type()]
+		hFn := ops.TypeTransforms[hVal.Type()]
+		if wFn == nil && hFn == nil {
+			return false, nil
+		}
+		if wFn != nil {
+			wTrn = wFn(wItf)
+		}
+		if hFn != nil {
+			hTrn = hFn(hItf)
+		}
+	}
+
+	tOps := ops
+	tOps.transformed = true
+	wtVal, htVal := reflect.ValueOf(wTrn), reflect.ValueOf(hTrn)
+	err := deepEqual(wtVal, htVal, visited, WithOptions(tOps))
+	if err == nil {
+		return true, nil
+	}
+
+	wStr, hStr := ops.Dumper.Any(wItf), ops.Dumper.Any(hItf)
+	msg := notice.From(err)
+	if msg.Prev() == nil && msg.Next() == nil && msg.Trail == ops.Trail {
+		_ = msg.
+			Append("want original", "%s", wStr).
+			Append("have original", "%s", hStr)
+		return true, msg
+	}
+	msg = notice.New("expected transformed values to be equal").
+		Append("want original", "%s", wStr).
+		Append("have original", "%s", hStr)
+	_ = notice.From(err).Head().Chain(AddRows(ops, msg))
+	return true, err
+}
+
+// equalMethod calls the "Equal(T) bool" or "Cmp(T) int" method defined on the
+// "want" value with the "have" value as an argument. Returns the name of the
+// called method and true when the values are equal according to it. Returns
+// an empty name when the "want" value has none of the methods or the methods
+// cannot be called.
+func equalMethod(wVal, hVal reflect.Value) (string, bool) {
+	if !wVal.CanInterface() || !hVal.CanInterface() {
+		return "", false
+	}
+	switch wVal.Kind() {
+	case reflect.Interface:
+		return "", false
+	case reflect.Pointer:
+		if wVal.IsNil() || hVal.IsNil() {
+			return "", false
+		}
+	default:
+	}
+
+	mth := wVal.MethodByName("Equal")
+	if mth.IsValid() && isEqualMethod(mth.Type(), hVal.Type(), reflect.Bool) {
+		return "Equal", mth.Call([]reflect.Value{hVal})[0].Bool()
+	}
+	mth = wVal.MethodByName("Cmp")
+	if mth.IsValid() && isEqualMethod(mth.Type(), hVal.Type(), reflect.Int) {
+		return "Cmp", mth.Call([]reflect.Value{hVal})[0].Int() == 0
+	}
+	return "", false
+}
+
+// isEqualMethod returns true when the method type takes exactly one argument
+// of the given type and returns exactly one value of the given kind.
+func isEqualMethod(mth, arg reflect.Type, ret reflect.Kind) bool {
+	return mth.NumIn() == 1 && !mth.IsVariadic() &&
+		arg.AssignableTo(mth.In(0)) &&
+		mth.NumOut() == 1 && mth.Out(0).Kind() == ret
+}
+
+// floatEqual compares floating point or complex numbers using the tolerance
+// set with [WithFloatDelta] or [WithFloatEpsilon]. The numbers are equal when
+// they are within any of the set tolerances. The "w" and "h" are "want" and
+// "have" values converted to complex numbers.
+func floatEqual(want, have any, w, h complex128, ops Options) error {
+	delta, epsilon := ops.FloatDelta, ops.FloatEpsilon
+	if delta <= 0 && epsilon <= 0 {
+		return equalError(want, have, WithOptions(ops))
+	}
+
+	hDelta := cmplx.Abs(w - h)
+	hEpsilon := hDelta / cmplx.Abs(w)
+	if (delta > 0 && hDelta <= delta) || (epsilon > 0 && hEpsilon <= epsilon) {
+		return nil
+	}
+
+	var header string
+	switch {
+	case delta > 0 && epsilon > 0:
+		header = "expected numbers to be within the given delta or epsilon"
+	case delta > 0:
+		header = "expected numbers to be within the given delta"
+	default:
+		header = "expected numbers to be within the given epsilon"
+	}
+	msg := notice.New(header).
+		Want("%s", ops.Dumper.Any(want)).
+		Have("%s", ops.Dumper.Any(have))
+	if delta > 0 {
+		_ = msg.
+			Append("want delta", "%s", formatFloat(delta)).
+			Append("have delta", "%s", formatFloat(hDelta))
+	}
+	if epsilon > 0 {
+		_ = msg.
+			Append("want epsilon", "%s", formatFloat(epsilon)).
+			Append("have epsilon", "%s", formatFloat(hEpsilon))
+	}
+	return AddRows(ops, msg)
+}
+
+// formatFloat formats the floating point number without the exponent.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// isUnordered returns true when the slice at the current trail should be
+// compared ignoring the order of elements.
+func isUnordered(ops Options) bool {
+	return ops.UnorderedSlices || slices.Contains(ops.UnorderedTrails, ops.Trail)
+}
+
+// unorderedEqual compares slices as multisets. Each "want" element must be
+// equal to a different "have" element. The pairs are found using maximum
+// bipartite matching, so the result does not depend on the order of elements
+// when equality is not transitive (e.g., [WithFloatDelta]). On failure, the
+// "want" elements without a match are reported as missing and the unmatched
+// "have" elements as extra.
+func unorderedEqual(
+	wVal, hVal reflect.Value,
+	visited map[visit]bool,
+	ops Options,
+) error {
+
+	if wVal.IsNil() && hVal.IsNil() {
+		ops.LogTrail()
+		return nil
+	}
+	if wVal.Len() == hVal.Len() && wVal.Pointer() == hVal.Pointer() {
+		ops.LogTrail()
+		return nil
+	}
+
+	// Probe all pairs without logging trails. Each probe gets a copy of the
+	// visited pointers, so failed probes do not mark pointers as equal.
+	pOps := ops
+	pOps.TrailLog = nil
+	match := make([][]bool, wVal.Len())
+	for i := range match {
+		iOps := pOps.ArrTrail(wVal.Kind().String(), i)
+		match[i] = make([]bool, hVal.Len())
+		for j := range match[i] {
+			vis := maps.Clone(visited)
+			e := deepEqual(wVal.Index(i), hVal.Index(j), vis, WithOptions(iOps))
+			match[i][j] = e == nil
+		}
+	}
+
+	// Find the maximum matching using augmenting paths.
+	owner := make([]int, hVal.Len()) // Index of the matched "want" element.
+	for j := range owner {
+		owner[j] = -1
+	}
+	var augment func(i int, seen []bool) bool
+	augment = func(i int, seen []bool) bool {
+		for j := range owner {
+			if !match[i][j] || seen[j] {
+				continue
+			}
+			seen[j] = true
+			if owner[j] < 0 || augment(owner[j], seen) {
+				owner[j] = i
+				return true
+			}
+		}
+		return false
+	}
+
+	missing := reflect.MakeSlice(wVal.Type(), 0, 0)
+	for i := 0; i < wVal.Len(); i++ {
+		if !augment(i, make([]bool, hVal.Len())) {
+			missing = reflect.Append(missing, wVal.Index(i))
+		}
+	}
+
+	// Compare matched elements again to log the trails.
+	if ops.TrailLog != nil {
+		for i := 0; i < wVal.Len(); i++ {
+			if j := slices.Index(owner, i); j >= 0 {
+				iOps := ops.ArrTrail(wVal.Kind().String(), i)
+				wiVal, hjVal := wVal.Index(i), hVal.Index(j)
+				_ = deepEqual(wiVal, hjVal, visited, WithOptions(iOps))
+			}
+		}
+	}
+
+	extra := reflect.MakeSlice(hVal.Type(), 0, 0)
+	for j, i := range owner {
+		if i < 0 {
+			extra = reflect.Append(extra, hVal.Index(j))
+		}
+	}
+
+	if missing.Len() == 0 && extra.Len() == 0 {
+		return nil
+	}
+	ops.LogTrail()
+	msg := notice.New("expected slices to have the same elements").
+		Append("want len", "%d", wVal.Len()).
+		Append("have len", "%d", hVal.Len())
+	if missing.Len() > 0 {
+		_ = msg.Append("missing", "%s", ops.Dumper.Value(missing))
+	}
+	if extra.Len() > 0 {
+		_ = msg.Append("extra", "%s", ops.Dumper.Value(extra))
+	}
+	return AddRows(ops, msg)
+}
+
+// keyedEqual compares slices with elements aligned by identity keys returned
+// by the function. See [WithSliceKey].
+func keyedEqual(
+	wVal, hVal reflect.Value,
+	visited map[visit]bool,
+	ops Options,
+	fn func(elem any) any,
+) error {
+
+	wKeys, err := sliceKeys(wVal, "want", fn, ops)
+	if err != nil {
+		return err
+	}
+	hKeys, err := sliceKeys(hVal, "have", fn, ops)
+	if err != nil {
+		return err
+	}
+	hIdx := make(map[any]int, len(hKeys))
+	for j, key := range hKeys {
+		hIdx[key] = j
+	}
+
+	kDmp := ops.Dumper
+	kDmp.Flat, kDmp.Compact = true, true
+
+	var removed, added, changed []string
+	for i, key := range wKeys {
+		j, ok := hIdx[key]
+		if !ok {
+			removed = append(removed, kDmp.Any(key))
+			continue
+		}
+		delete(hIdx, key)
+		iOps := ops.ArrTrail(wVal.Kind().String(), i)
+		e := deepEqual(wVal.Index(i), hVal.Index(j), visited, WithOptions(iOps))
+		if e != nil {
+			changed = append(changed, kDmp.Any(key))
+			err = notice.Join(err, e)
+		}
+	}
+	for _, key := range hKeys {
+		if _, ok := hIdx[key]; ok {
+			added = append(added, kDmp.Any(key))
+		}
+	}
+
+	if len(removed) == 0 && len(added) == 0 && len(changed) == 0 {
+		return nil
+	}
+	ops.LogTrail()
+	msg := notice.New("expected slices to have the same elements by key")
+	if len(removed) > 0 {
+		_ = msg.Append("removed", "%s", strings.Join(removed, ", "))
+	}
+	if len(added) > 0 {
+		_ = msg.Append("added", "%s", strings.Join(added, ", "))
+	}
+	if len(changed) > 0 {
+		_ = msg.Append("changed", "%s", strings.Join(changed, ", "))
+	}
+	msg = AddRows(ops, msg)
+	if err == nil {
+		return msg
+	}
+	// Put the summary in front of the changed elements notices.
+	_ = notice.From(err).Head().Chain(msg)
+	return err
+}
+
+// sliceKeys returns the identity keys of the slice elements. Returns an error
+// when the key cannot be computed, is not comparable or is not unique. The
+// "arg" is the name of the argument reported in the error.
+func sliceKeys(
+	val reflect.Value,
+	arg string,
+	fn func(elem any) any,
+	ops Options,
+) ([]any, error) {
+
+	keys := make([]any, 0, val.Len())
+	seen := make(map[any]bool, val.Len())
+	for i := 0; i < val.Len(); i++ {
+		iOps := ops.ArrTrail(val.Kind().String(), i)
+		elem, ok := core.Value(val.Index(i))
+		if !ok {
+			msg := notice.New("not able to get a slice element key").
+				Append("argument", "%s", arg).
+				Append("cause", "%s", "cannot access the element value")
+			return nil, AddRows(iOps, msg)
+		}
+		key := fn(elem)
+		if key != nil && !reflect.TypeOf(key).Comparable() {
+			msg := notice.New("not able to get a slice element key").
+				Append("argument", "%s", arg).
+				Append("cause", "%s", "key is not comparable").
+				Append("key type", "%T", key)
+			return nil, AddRows(iOps, msg)
+		}
+		if seen[key] {
+			msg := notice.New("expected unique slice element keys").
+				Append("argument", "%s", arg).
+				Append("key", "%s", ops.Dumper.Any(key))
+			return nil, AddRows(iOps, msg)
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
 // equalError builds the standard "expected values to be equal" notice.
 func equalError(want, have any, opts ...any) *notice.Notice {
 	wTyp, hTyp := fmt.Sprintf("%T", want), fmt.Sprintf("%T", have)
@@ -6,7 +6,13 @@ package check
 import (
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"net"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 	"unsafe"
@@ -14,6 +20,7 @@ import (
 	"github.com/ctx42/testing/internal/affirm"
 	"github.com/ctx42/testing/pkg/dump"
 	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/notice"
 	"github.com/ctx42/testing/pkg/testcases"
 )
 
@@ -611,6 +618,25 @@ func Test_Equal_kind_Struct(t *testing.T) {
 		affirm.DeepEqual(t, []string{"TIntStr.Int", "TIntStr.Str"}, trail)
 	})
 
+	t.Run("not equal nested structs with multiple errors", func(t *testing.T) {
+		// --- Given ---
+		want := testcases.TB{
+			TA:  testcases.TA{Int: 1, Str: "a"},
+			TAv: testcases.TA{Int: 2, Str: "b"},
+		}
+		have := testcases.TB{
+			TA:  testcases.TA{Int: 3, Str: "c"},
+			TAv: testcases.TA{Int: 4, Str: "d"},
+		}
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, 4, len(notice.From(err).All()))
+	})
+
 	t.Run("not equal when want is the nil struct pointer", func(t *testing.T) {
 		// --- Given ---
 		var want *testcases.TA
@@ -1079,6 +1105,1042 @@ func Test_Equal_kind_Slice_and_Array(t *testing.T) {
 	})
 }
 
+func Test_Equal_unordered_slices(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := []int{1, 2, 2, 3}
+		have := []int{2, 3, 1, 2}
+
+		// --- When ---
+		err := Equal(want, have, WithUnorderedSlices())
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal logs want element trails", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []any{WithTrailLog(&trail), WithUnorderedSlices()}
+
+		want := []testcases.TIntStr{{Int: 1}, {Int: 2}}
+		have := []testcases.TIntStr{{Int: 2}, {Int: 1}}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		wTrail := []string{
+			"<slice>[0].Int",
+			"<slice>[0].Str",
+			"<slice>[1].Int",
+			"<slice>[1].Str",
+		}
+		affirm.DeepEqual(t, wTrail, trail)
+	})
+
+	t.Run("equal nil and empty", func(t *testing.T) {
+		// --- When ---
+		err := Equal([]int(nil), []int{}, WithUnorderedSlices())
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal same slice instance", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []any{
+			WithTrailLog(&trail),
+			WithTrail("type.field"),
+			WithUnorderedSlices(),
+		}
+		want := []int{1, 2}
+
+		// --- When ---
+		err := Equal(want, want, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.DeepEqual(t, []string{"type.field"}, trail)
+	})
+
+	t.Run("missing and extra elements", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []any{
+			WithTrailLog(&trail),
+			WithTrail("type.field"),
+			WithUnorderedSlices(),
+		}
+		want := []int{1, 2, 2, 3}
+		have := []int{3, 2, 4, 1}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected slices to have the same elements:\n" +
+			"     trail: type.field\n" +
+			"  want len: 4\n" +
+			"  have len: 4\n" +
+			"   missing:\n" +
+			"            []int{\n" +
+			"              2,\n" +
+			"            }\n" +
+			"     extra:\n" +
+			"            []int{\n" +
+			"              4,\n" +
+			"            }"
+		affirm.Equal(t, wMsg, err.Error())
+		wTrail := []string{
+			"type.field[0]",
+			"type.field[1]",
+			"type.field[3]",
+			"type.field",
+		}
+		affirm.DeepEqual(t, wTrail, trail)
+	})
+
+	t.Run("equal with float delta in any order", func(t *testing.T) {
+		// --- Given ---
+		opts := []any{WithUnorderedSlices(), WithFloatDelta(0.1)}
+
+		// The first "want" element is within delta of both "have" elements,
+		// the second one only of the first "have" element.
+		want := []float64{1.05, 0.95}
+		have := []float64{1.0, 1.12}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("different lengths", func(t *testing.T) {
+		// --- Given ---
+		want := []string{"a", "b"}
+		have := []string{"b"}
+
+		// --- When ---
+		err := Equal(want, have, WithUnorderedSlices())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected slices to have the same elements:\n" +
+			"  want len: 2\n" +
+			"  have len: 1\n" +
+			"   missing:\n" +
+			"            []string{\n" +
+			"              \"a\",\n" +
+			"            }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("elements compared with deep equality", func(t *testing.T) {
+		// --- Given ---
+		want := []*testcases.TA{{Int: 1, TAp: &testcases.TA{Int: 2}}, {Int: 3}}
+		have := []*testcases.TA{{Int: 3}, {Int: 1, TAp: &testcases.TA{Int: 2}}}
+
+		// --- When ---
+		err := Equal(want, have, WithUnorderedSlices())
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("nested slices", func(t *testing.T) {
+		// --- Given ---
+		want := [][]int{{1, 2}, {3}}
+		have := [][]int{{3}, {2, 1}}
+
+		// --- When ---
+		err := Equal(want, have, WithUnorderedSlices())
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("arrays are compared in order", func(t *testing.T) {
+		// --- Given ---
+		want := [...]int{1, 2}
+		have := [...]int{2, 1}
+
+		// --- When ---
+		err := Equal(want, have, WithUnorderedSlices())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+	})
+
+	t.Run("unordered trail", func(t *testing.T) {
+		// --- Given ---
+		want := testcases.TNested{SInt: []int{1, 2}, STA: []testcases.TA{{Int: 1}}}
+		have := testcases.TNested{SInt: []int{2, 1}, STA: []testcases.TA{{Int: 1}}}
+
+		// --- When ---
+		err := Equal(want, have, WithUnorderedTrail("TNested.SInt"))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not unordered trail", func(t *testing.T) {
+		// --- Given ---
+		want := testcases.TNested{SInt: []int{1, 2}}
+		have := testcases.TNested{SInt: []int{2, 1}}
+
+		// --- When ---
+		err := Equal(want, have, WithUnorderedTrail("TNested.STA"))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"multiple expectations violated:\n" +
+			"  error: expected values to be equal\n" +
+			"  trail: TNested.SInt[0]\n" +
+			"   want: 1\n" +
+			"   have: 2\n" +
+			"      ---\n" +
+			"  error: expected values to be equal\n" +
+			"  trail: TNested.SInt[1]\n" +
+			"   want: 2\n" +
+			"   have: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Equal_slice_key(t *testing.T) {
+	keyInt := func(elem any) any { return elem.(testcases.TIntStr).Int }
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []any{
+			WithTrailLog(&trail),
+			WithSliceKey("type.field", keyInt),
+			WithTrail("type.field"),
+		}
+		want := []testcases.TIntStr{{Int: 1, Str: "a"}, {Int: 2, Str: "b"}}
+		have := []testcases.TIntStr{{Int: 2, Str: "b"}, {Int: 1, Str: "a"}}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		wTrail := []string{
+			"type.field[0].Int",
+			"type.field[0].Str",
+			"type.field[1].Int",
+			"type.field[1].Str",
+		}
+		affirm.DeepEqual(t, wTrail, trail)
+	})
+
+	t.Run("added removed and changed", func(t *testing.T) {
+		// --- Given ---
+		opts := []any{
+			WithSliceKey("TNested.STA", func(elem any) any {
+				return elem.(testcases.TA).Str
+			}),
+		}
+		want := testcases.TNested{
+			STA: []testcases.TA{{Str: "a", Int: 1}, {Str: "b"}, {Str: "c"}},
+		}
+		have := testcases.TNested{
+			STA: []testcases.TA{{Str: "d"}, {Str: "c"}, {Str: "a", Int: 2}},
+		}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"multiple expectations violated:\n" +
+			"    error: expected slices to have the same elements by key\n" +
+			"    trail: TNested.STA\n" +
+			"  removed: \"b\"\n" +
+			"    added: \"d\"\n" +
+			"  changed: \"a\"\n" +
+			"        ---\n" +
+			"    error: expected values to be equal\n" +
+			"    trail: TNested.STA[0].Int\n" +
+			"     want: 1\n" +
+			"     have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("only added and removed", func(t *testing.T) {
+		// --- Given ---
+		opt := WithSliceKey("", keyInt)
+		want := []testcases.TIntStr{{Int: 1, Str: "a"}, {Int: 2, Str: "b"}}
+		have := []testcases.TIntStr{
+			{Int: 3, Str: "a"},
+			{Int: 2, Str: "b"},
+			{Int: 4, Str: "b"},
+		}
+
+		// --- When ---
+		err := Equal(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected slices to have the same elements by key:\n" +
+			"  removed: 1\n" +
+			"    added: 3, 4"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not matching trail uses indexes", func(t *testing.T) {
+		// --- Given ---
+		opt := WithSliceKey("other", keyInt)
+		want := []testcases.TIntStr{{Int: 1, Str: "a"}, {Int: 2, Str: "b"}}
+		have := []testcases.TIntStr{{Int: 2, Str: "b"}, {Int: 1, Str: "a"}}
+
+		// --- When ---
+		err := Equal(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+	})
+
+	t.Run("duplicate want keys", func(t *testing.T) {
+		// --- Given ---
+		opt := WithSliceKey("", keyInt)
+		want := []testcases.TIntStr{{Int: 1, Str: "a"}, {Int: 1, Str: "b"}}
+		have := []testcases.TIntStr{{Int: 1, Str: "a"}}
+
+		// --- When ---
+		err := Equal(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected unique slice element keys:\n" +
+			"     trail: <slice>[1]\n" +
+			"  argument: want\n" +
+			"       key: 1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("duplicate have keys", func(t *testing.T) {
+		// --- Given ---
+		opt := WithSliceKey("", keyInt)
+		want := []testcases.TIntStr{{Int: 1, Str: "a"}}
+		have := []testcases.TIntStr{{Int: 2, Str: "a"}, {Int: 2, Str: "b"}}
+
+		// --- When ---
+		err := Equal(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected unique slice element keys:\n" +
+			"     trail: <slice>[1]\n" +
+			"  argument: have\n" +
+			"       key: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not comparable key", func(t *testing.T) {
+		// --- Given ---
+		opt := WithSliceKey("", func(elem any) any { return []int{1} })
+		want := []int{1}
+		have := []int{1}
+
+		// --- When ---
+		err := Equal(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"not able to get a slice element key:\n" +
+			"     trail: <slice>[0]\n" +
+			"  argument: want\n" +
+			"     cause: key is not comparable\n" +
+			"  key type: []int"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_Equal_all_diffs(t *testing.T) {
+	t.Run("nested structures", func(t *testing.T) {
+		// --- Given ---
+		want := testcases.TB{
+			TA:  testcases.TA{Int: 1, Str: "a"},
+			TAv: testcases.TA{Int: 2, Str: "b"},
+		}
+		have := testcases.TB{
+			TA:  testcases.TA{Int: 3, Str: "c"},
+			TAv: testcases.TA{Int: 4, Str: "d"},
+		}
+
+		// --- When ---
+		err := Equal(want, have, WithAllDiffs())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		var trails []string
+		for _, msg := range notice.From(err).All() {
+			trails = append(trails, msg.Trail)
+		}
+		wTrails := []string{
+			"TB.TA.Int",
+			"TB.TA.Str",
+			"TB.TAv.Int",
+			"TB.TAv.Str",
+		}
+		affirm.DeepEqual(t, wTrails, trails)
+	})
+
+	t.Run("slices with different lengths", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []any{WithAllDiffs(), WithTrailLog(&trail)}
+		want := testcases.TNested{SInt: []int{1, 2, 3}}
+		have := testcases.TNested{SInt: []int{1, 0}}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"multiple expectations violated:\n" +
+			"  error: expected values to be equal\n" +
+			"  trail: TNested.SInt[1]\n" +
+			"   want: 2\n" +
+			"   have: 0\n" +
+			"      ---\n" +
+			"  error: expected slice element to exist\n" +
+			"  trail: TNested.SInt[2]\n" +
+			"   want: 3"
+		affirm.Equal(t, wMsg, err.Error())
+		wTrail := []string{
+			"TNested.SInt[0]",
+			"TNested.SInt[1]",
+			"TNested.SInt[2]",
+			"TNested.STA",
+			"TNested.STAp",
+			"TNested.MStrInt",
+			"TNested.MStrTyp",
+			"TNested.MIntTyp",
+		}
+		affirm.DeepEqual(t, wTrail, trail)
+	})
+
+	t.Run("extra slice elements", func(t *testing.T) {
+		// --- When ---
+		err := Equal([]int{1}, []int{1, 2}, WithAllDiffs())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected slice element not to exist:\n" +
+			"  trail: <slice>[1]\n" +
+			"   have: 2"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("maps with different keys", func(t *testing.T) {
+		// --- Given ---
+		want := map[string]int{"a": 1, "b": 2, "c": 3}
+		have := map[string]int{"a": 0, "c": 3, "d": 4, "e": 5}
+
+		// --- When ---
+		err := Equal(want, have, WithAllDiffs())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"multiple expectations violated:\n" +
+			"  error: expected values to be equal\n" +
+			"  trail: map[\"a\"]\n" +
+			"   want: 1\n" +
+			"   have: 0\n" +
+			"      ---\n" +
+			"  error: expected map key to exist\n" +
+			"  trail: map[\"b\"]\n" +
+			"   want: 2\n" +
+			"      ---\n" +
+			"  error: expected map key not to exist\n" +
+			"  trail: map[\"d\"]\n" +
+			"   have: 4\n" +
+			"      ---\n" +
+			"  error: expected map key not to exist\n" +
+			"  trail: map[\"e\"]\n" +
+			"   have: 5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("equal maps", func(t *testing.T) {
+		// --- Given ---
+		want := map[string]int{"a": 1, "b": 2}
+		have := map[string]int{"b": 2, "a": 1}
+
+		// --- When ---
+		err := Equal(want, have, WithAllDiffs())
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("sorted by trail", func(t *testing.T) {
+		// --- Given ---
+		want := map[string][]int{"a": {1, 2}, "b": {3}}
+		have := map[string][]int{"a": {0, 0}, "b": {0}}
+
+		// --- When ---
+		err := Equal(want, have, WithAllDiffs())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		var trails []string
+		for _, msg := range notice.From(err).All() {
+			trails = append(trails, msg.Trail)
+		}
+		wTrails := []string{`map["a"][0]`, `map["a"][1]`, `map["b"][0]`}
+		affirm.DeepEqual(t, wTrails, trails)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		// --- Given ---
+		opts := []any{WithAllDiffs(), WithMaxDiffs(2)}
+
+		// --- When ---
+		err := Equal([]int{1, 2, 3, 4}, []int{0, 0, 0, 0}, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"multiple expectations violated:\n" +
+			"     error: expected values to be equal\n" +
+			"     trail: <slice>[0]\n" +
+			"      want: 1\n" +
+			"      have: 0\n" +
+			"         ---\n" +
+			"     error: expected values to be equal\n" +
+			"     trail: <slice>[1]\n" +
+			"      want: 2\n" +
+			"      have: 0\n" +
+			"         ---\n" +
+			"     error: too many differences to report\n" +
+			"  reported: 2\n" +
+			"     total: 4"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("limit not reached", func(t *testing.T) {
+		// --- Given ---
+		opts := []any{WithAllDiffs(), WithMaxDiffs(2)}
+
+		// --- When ---
+		err := Equal([]int{1, 2}, []int{0, 0}, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, 2, len(notice.From(err).All()))
+	})
+}
+
+func Test_Equal_float_tolerance(t *testing.T) {
+	t.Run("within delta", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			F32 float32
+			F64 float64
+			C64 complex64
+			C12 complex128
+		}
+		want := T{F32: 1.0, F64: 2.0, C64: 3 + 1i, C12: 4 + 1i}
+		have := T{F32: 1.05, F64: 2.05, C64: 3.05 + 1i, C12: 4 + 1.05i}
+
+		// --- When ---
+		err := Equal(want, have, WithFloatDelta(0.1))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not within delta", func(t *testing.T) {
+		// --- Given ---
+		want := map[string]float64{"A": 1.5}
+		have := map[string]float64{"A": 2}
+
+		// --- When ---
+		err := Equal(want, have, WithFloatDelta(0.1))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected numbers to be within the given delta:\n" +
+			"       trail: map[\"A\"]\n" +
+			"        want: 1.5\n" +
+			"        have: 2\n" +
+			"  want delta: 0.1\n" +
+			"  have delta: 0.5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("not within delta complex", func(t *testing.T) {
+		// --- When ---
+		err := Equal(1+1i, 4+5i, WithFloatDelta(1))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected numbers to be within the given delta:\n" +
+			"        want: (1+1i)\n" +
+			"        have: (4+5i)\n" +
+			"  want delta: 1\n" +
+			"  have delta: 5"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("within epsilon", func(t *testing.T) {
+		// --- Given ---
+		opt := WithFloatEpsilon(0.01)
+
+		// --- When ---
+		err := Equal([]float64{100, 200}, []float64{101, 198}, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not within epsilon", func(t *testing.T) {
+		// --- When ---
+		err := Equal(100.0, 110.0, WithFloatEpsilon(0.01))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected numbers to be within the given epsilon:\n" +
+			"          want: 100\n" +
+			"          have: 110\n" +
+			"  want epsilon: 0.01\n" +
+			"  have epsilon: 0.1"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("within delta or epsilon", func(t *testing.T) {
+		// --- Given ---
+		opts := []any{WithFloatDelta(0.1), WithFloatEpsilon(0.01)}
+
+		// --- When ---
+		err := Equal([]float64{0, 100}, []float64{0.05, 101}, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not within delta or epsilon", func(t *testing.T) {
+		// --- Given ---
+		opts := []any{WithFloatDelta(1), WithFloatEpsilon(0.01)}
+
+		// --- When ---
+		err := Equal(100.0, 102.0, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected numbers to be within the given delta or epsilon:\n" +
+			"          want: 100\n" +
+			"          have: 102\n" +
+			"    want delta: 1\n" +
+			"    have delta: 2\n" +
+			"  want epsilon: 0.01\n" +
+			"  have epsilon: 0.02"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("delta for trail and nested values", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			A []float64
+			B float64
+		}
+		want := T{A: []float64{1, 2}, B: 3}
+		have := T{A: []float64{1.05, 2.05}, B: 3.05}
+
+		// --- When ---
+		err := Equal(want, have, WithFloatDeltaTrail("T.A", 0.1))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: T.B\n" +
+			"   want: 3\n" +
+			"   have: 3.05"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("trail delta overrides global delta", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			A float64
+			B float64
+		}
+		opts := []any{WithFloatDelta(0.1), WithFloatDeltaTrail("T.B", 0.01)}
+		want := T{A: 1, B: 2}
+		have := T{A: 1.05, B: 2.05}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "T.B", notice.From(err).Trail)
+	})
+
+	t.Run("epsilon for trail", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			A float64
+			B float64
+		}
+		want := T{A: 100, B: 100}
+		have := T{A: 101, B: 101}
+
+		// --- When ---
+		err := Equal(want, have, WithFloatEpsilonTrail("T.A", 0.1))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "T.B", notice.From(err).Trail)
+	})
+
+	t.Run("NaN is never within delta", func(t *testing.T) {
+		// --- When ---
+		err := Equal(math.NaN(), math.NaN(), WithFloatDelta(1))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+	})
+}
+
+func Test_Equal_equal_methods(t *testing.T) {
+	t.Run("equal by Equal method", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []any{WithEqualMethods(), WithTrailLog(&trail)}
+		want := []testcases.TEqual{{ID: 1, Str: "a"}}
+		have := []testcases.TEqual{{ID: 1, Str: "b"}}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.DeepEqual(t, []string{"<slice>[0] <Equal>"}, trail)
+	})
+
+	t.Run("not equal by Equal method", func(t *testing.T) {
+		// --- Given ---
+		want := testcases.TEqual{ID: 1, Str: "a"}
+		have := testcases.TEqual{ID: 2, Str: "a"}
+
+		// --- When ---
+		err := Equal(want, have, WithEqualMethods())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"  trail: <Equal>\n" +
+			"   want:\n" +
+			"         {\n" +
+			"           ID: 1,\n" +
+			"           Str: \"a\",\n" +
+			"         }\n" +
+			"   have:\n" +
+			"         {\n" +
+			"           ID: 2,\n" +
+			"           Str: \"a\",\n" +
+			"         }\n" +
+			"   diff:\n" +
+			"         @@ -1,4 +1,4 @@\n" +
+			"          {\n" +
+			"         -  ID: 2,\n" +
+			"         +  ID: 1,\n" +
+			"            Str: \"a\",\n" +
+			"          }"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("equal by Cmp method", func(t *testing.T) {
+		// --- Given ---
+		trail := make([]string, 0)
+		opts := []any{WithEqualMethods(), WithTrailLog(&trail)}
+		want := map[string]*testcases.TCmp{"a": {ID: 1, Str: "a"}}
+		have := map[string]*testcases.TCmp{"a": {ID: 1, Str: "b"}}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.DeepEqual(t, []string{`map["a"] <Cmp>`}, trail)
+	})
+
+	t.Run("not equal by Cmp method", func(t *testing.T) {
+		// --- Given ---
+		want := &testcases.TCmp{ID: 1}
+		have := &testcases.TCmp{ID: 2}
+
+		// --- When ---
+		err := Equal(want, have, WithEqualMethods())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "<Cmp>", notice.From(err).Trail)
+	})
+
+	t.Run("nil pointers are not compared by method", func(t *testing.T) {
+		// --- Given ---
+		want := &testcases.TCmp{ID: 1}
+
+		// --- When ---
+		err := Equal(want, (*testcases.TCmp)(nil), WithEqualMethods())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "", notice.From(err).Trail)
+	})
+
+	t.Run("standard library types", func(t *testing.T) {
+		// --- Given ---
+		type T struct {
+			IP  net.IP
+			Num *big.Int
+		}
+		want := T{IP: net.ParseIP("127.0.0.1"), Num: big.NewInt(42)}
+		have := T{IP: net.IPv4(127, 0, 0, 1).To4(), Num: big.NewInt(42)}
+
+		// --- When ---
+		err := Equal(want, have, WithEqualMethods())
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("without option compared field by field", func(t *testing.T) {
+		// --- Given ---
+		want := testcases.TEqual{ID: 1, Str: "a"}
+		have := testcases.TEqual{ID: 1, Str: "b"}
+
+		// --- When ---
+		err := Equal(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "TEqual.Str", notice.From(err).Trail)
+	})
+
+	t.Run("custom type checker takes precedence", func(t *testing.T) {
+		// --- Given ---
+		chk := func(want, have any, opts ...any) error { return nil }
+		opts := []any{
+			WithEqualMethods(),
+			WithTypeChecker(testcases.TEqual{}, chk),
+		}
+		want := testcases.TEqual{ID: 1}
+		have := testcases.TEqual{ID: 2}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+}
+
+func Test_Equal_transform(t *testing.T) {
+	lower := func(val any) any { return strings.ToLower(val.(string)) }
+
+	t.Run("equal after type transform", func(t *testing.T) {
+		// --- Given ---
+		want := testcases.TIntStr{Int: 1, Str: "ABC"}
+		have := testcases.TIntStr{Int: 1, Str: "abc"}
+
+		// --- When ---
+		err := Equal(want, have, WithTransform("", lower))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal after type transform", func(t *testing.T) {
+		// --- Given ---
+		want := testcases.TIntStr{Int: 1, Str: "ABC"}
+		have := testcases.TIntStr{Int: 1, Str: "xyz"}
+
+		// --- When ---
+		err := Equal(want, have, WithTransform("", lower))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"expected values to be equal:\n" +
+			"          trail: TIntStr.Str\n" +
+			"           want: \"abc\"\n" +
+			"           have: \"xyz\"\n" +
+			"  want original: \"ABC\"\n" +
+			"  have original: \"xyz\""
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("trail transform", func(t *testing.T) {
+		// --- Given ---
+		opt := WithTrailTransform("TIntStr.Str", lower)
+		want := testcases.TIntStr{Int: 1, Str: "ABC"}
+		have := testcases.TIntStr{Int: 1, Str: "abc"}
+
+		// --- When ---
+		err := Equal(want, have, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("trail transform takes precedence", func(t *testing.T) {
+		// --- Given ---
+		upper := func(val any) any { return strings.ToUpper(val.(string)) }
+		opts := []any{
+			WithTransform("", func(val any) any { return "x" }),
+			WithTrailTransform("TIntStr.Str", upper),
+		}
+		want := testcases.TIntStr{Int: 1, Str: "ABC"}
+		have := testcases.TIntStr{Int: 1, Str: "xyz"}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.Equal(t, "TIntStr.Str", notice.From(err).Trail)
+	})
+
+	t.Run("transform to a different type", func(t *testing.T) {
+		// --- Given ---
+		length := func(val any) any { return len(val.(string)) }
+		want := map[string]string{"a": "abc"}
+		have := map[string]string{"a": "xyz"}
+
+		// --- When ---
+		err := Equal(want, have, WithTrailTransform(`map["a"]`, length))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal nested values of transformed value", func(t *testing.T) {
+		// --- Given ---
+		sorted := func(val any) any {
+			s := slices.Clone(val.([]int))
+			slices.Sort(s)
+			return s
+		}
+		want := testcases.TNested{SInt: []int{3, 1, 2}}
+		have := testcases.TNested{SInt: []int{2, 4, 1}}
+
+		// --- When ---
+		err := Equal(want, have, WithTrailTransform("TNested.SInt", sorted))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"multiple expectations violated:\n" +
+			"          error: expected transformed values to be equal\n" +
+			"          trail: TNested.SInt\n" +
+			"  want original:\n" +
+			"                 []int{\n" +
+			"                   3,\n" +
+			"                   1,\n" +
+			"                   2,\n" +
+			"                 }\n" +
+			"  have original:\n" +
+			"                 []int{\n" +
+			"                   2,\n" +
+			"                   4,\n" +
+			"                   1,\n" +
+			"                 }\n" +
+			"              ---\n" +
+			"          error: expected values to be equal\n" +
+			"          trail: TNested.SInt[2]\n" +
+			"           want: 3\n" +
+			"           have: 4"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("transformed values use type checkers", func(t *testing.T) {
+		// --- Given ---
+		var called bool
+		chk := func(want, have any, opts ...any) error {
+			called = true
+			return nil
+		}
+		opts := []any{
+			WithTransform(0, func(val any) any { return strconv.Itoa(val.(int)) }),
+			WithTypeChecker("", chk),
+		}
+
+		// --- When ---
+		err := Equal(1, 2, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, true, called)
+	})
+
+	t.Run("skipped trails are not transformed", func(t *testing.T) {
+		// --- Given ---
+		var called bool
+		fn := func(val any) any {
+			called = true
+			return val
+		}
+		opts := []any{
+			WithTransform("", fn),
+			WithSkipTrail("TIntStr.Str"),
+		}
+		want := testcases.TIntStr{Int: 1, Str: "ABC"}
+		have := testcases.TIntStr{Int: 1, Str: "xyz"}
+
+		// --- When ---
+		err := Equal(want, have, opts...)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, false, called)
+	})
+
+	t.Run("transformed values are not transformed again", func(t *testing.T) {
+		// --- Given ---
+		var count int
+		fn := func(val any) any {
+			count++
+			return strings.TrimSpace(val.(string))
+		}
+
+		// --- When ---
+		err := Equal(" abc", "abc ", WithTransform("", fn))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, 2, count)
+	})
+}
+
 func Test_Equal_kind_Map(t *testing.T) {
 	t.Run("equal map", func(t *testing.T) {
 		// --- Given ---
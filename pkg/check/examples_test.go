@@ -325,9 +325,22 @@ func ExampleJSON() {
 
 	fmt.Println(err)
 	// Output:
-	// expected JSON strings to be equal:
-	//   want: {"A":1,"B":2}
-	//   have: {"A":1,"B":3}
+	// multiple expectations violated:
+	//   error: expected JSON strings to be equal
+	//    diff:
+	//          --- want
+	//          +++ have
+	//          @@ -1,4 +1,4 @@
+	//           {
+	//             "A": 1,
+	//          -  "B": 2
+	//          +  "B": 3
+	//           }
+	//       ---
+	//   error: expected values to be equal
+	//   trail: /B
+	//    want: 2
+	//    have: 3
 }
 
 func ExampleTime() {
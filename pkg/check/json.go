@@ -5,7 +5,12 @@ package check
 
 import (
 	"encoding/json"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/ctx42/testing/internal/diff"
 	"github.com/ctx42/testing/pkg/notice"
 )
 
@@ -18,37 +23,358 @@ type Text interface {
 // JSON checks that two JSON texts are equivalent (after unmarshalling).
 // See [assert.JSON].
 //
+// On failure, the returned error starts with a unified diff of both documents
+// pretty-printed, followed by a notice for each differing value. The trails
+// of the values are JSON Pointers (RFC 6901) like "/items/3/price" prefixed
+// with the trail set by the [WithTrail] option. The same trails are used by
+// the [WithSkipTrail], [WithTrailChecker] and [WithTrailLog] options.
+//
 // Example:
 //
 //	check.JSON(`{"hello": "world"}`, `{"foo": "bar"}`)
+//	check.JSON(want, have, check.WithSkipTrail("/id", "/items/0/created"))
 func JSON[W, H Text](want W, have H, opts ...any) error {
-	var wantItf, haveItf any
+	ops := DefaultOptions(opts...)
+	wantItf, haveItf, err := jsonUnmarshal(want, have, ops)
+	if err != nil {
+		return err
+	}
+
+	return docEqual("expected JSON strings to be equal", wantItf, haveItf, ops)
+}
 
+// docEqual compares documents unmarshalled to generic values like the ones
+// [json.Unmarshal] creates. On failure, it returns a notice with the given
+// header and a unified diff of the documents, followed by notices for all
+// differing trails (see [jsonEqual]).
+func docEqual(header string, want, have any, ops Options) error {
+	norm, ers := jsonEqual(want, have, ops)
+	if len(ers) == 0 {
+		return nil
+	}
+	msg := notice.New(header)
+	if dif := jsonDiff(want, norm); dif != "" {
+		_ = msg.Append("diff", "%s", dif)
+	}
+	return notice.Join(append([]error{AddRows(ops, msg)}, ers...)...)
+}
+
+// JSONSubset checks that the "want" JSON text is a subset of the "have" JSON
+// text. Every key and array element in "want" must be present and equal in
+// "have". Extra object keys in "have" are ignored.
+//
+// By default, array elements are compared by index, each "want" element must
+// be a subset of the "have" element with the same index, and extra "have"
+// elements are ignored. With the [WithUnorderedArrays] option, each "want"
+// element must be a subset of a different "have" element at any position.
+//
+// The trails in the returned error are JSON Pointers, see [JSON] for details.
+//
+// Example:
+//
+//	check.JSONSubset(`{"id": 1}`, `{"id": 1, "name": "John"}`)
+//	check.JSONSubset(`[2, 1]`, `[1, 2, 3]`, check.WithUnorderedArrays())
+func JSONSubset[W, H Text](want W, have H, opts ...any) error {
 	ops := DefaultOptions(opts...)
-	if err := json.Unmarshal(toBytes(want), &wantItf); err != nil {
-		msg := notice.New("did not expect the unmarshalling error").
-			Append("argument", "want").
-			Append("error", "%s", err)
-		return AddRows(ops, msg)
+	wantItf, haveItf, err := jsonUnmarshal(want, have, ops)
+	if err != nil {
+		return err
+	}
+	return notice.Join(jsonSubset(wantItf, haveItf, ops)...)
+}
+
+// jsonUnmarshal unmarshalls "want" and "have" JSON texts.
+func jsonUnmarshal[W, H Text](want W, have H, ops Options) (any, any, error) {
+	wantItf, err := unmarshalJSON(toBytes(want), "want", ops)
+	if err != nil {
+		return nil, nil, err
 	}
-	if err := json.Unmarshal(toBytes(have), &haveItf); err != nil {
+	haveItf, err := unmarshalJSON(toBytes(have), "have", ops)
+	if err != nil {
+		return nil, nil, err
+	}
+	return wantItf, haveItf, nil
+}
+
+// unmarshalJSON unmarshalls JSON text. The "arg" is the name of the argument
+// reported in the unmarshalling error.
+func unmarshalJSON(data []byte, arg string, ops Options) (any, error) {
+	var itf any
+	if err := json.Unmarshal(data, &itf); err != nil {
 		msg := notice.New("did not expect the unmarshalling error").
-			Append("argument", "have").
+			Append("argument", "%s", arg).
 			Append("error", "%s", err)
-		return AddRows(ops, msg)
+		return nil, AddRows(ops, msg)
+	}
+	return itf, nil
+}
+
+// jsonTrail handles skipped trails (see [WithSkipTrail]) and custom trail
+// checkers (see [WithTrailChecker]) for unmarshalled JSON values. Returns true
+// when the value at the current trail was handled, in which case the error is
+// the custom checker error.
+func jsonTrail(want, have any, ops Options) (bool, error) {
+	if slices.Contains(ops.SkipTrails, ops.Trail) {
+		ops.Trail += " <skipped>"
+		ops.LogTrail()
+		return true, nil
+	}
+	if chk := ops.TrailCheckers[ops.Trail]; chk != nil {
+		ops.LogTrail()
+		return true, chk(want, have, WithOptions(ops))
+	}
+	return false, nil
+}
+
+// jsonEqual recursively compares unmarshalled JSON values building JSON
+// Pointer trails (see [Options.PointerTrail]). It returns errors for all
+// differing trails and the "have" value with skipped and custom checked values
+// replaced by the "want" values, so they do not show in the diff.
+func jsonEqual(want, have any, ops Options) (any, []error) {
+	if ok, err := jsonTrail(want, have, ops); ok {
+		if err != nil {
+			return have, []error{err}
+		}
+		return want, nil
+	}
+
+	switch w := want.(type) {
+	case map[string]any:
+		if h, ok := have.(map[string]any); ok {
+			return jsonObject(w, h, ops)
+		}
+	case []any:
+		if h, ok := have.([]any); ok {
+			return jsonArray(w, h, ops)
+		}
+	}
+
+	if err := Equal(want, have, WithOptions(ops)); err != nil {
+		return have, []error{err}
+	}
+	return have, nil
+}
+
+// jsonObject compares JSON objects key by key. See [jsonEqual].
+func jsonObject(want, have map[string]any, ops Options) (any, []error) {
+	keys := slices.Collect(maps.Keys(want))
+	for key := range have {
+		if _, ok := want[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var ers []error
+	norm := make(map[string]any, len(have))
+	for _, key := range keys {
+		kOps := ops.PointerTrail(key)
+		wVal, wOK := want[key]
+		hVal, hOK := have[key]
+
+		if slices.Contains(kOps.SkipTrails, kOps.Trail) {
+			kOps.Trail += " <skipped>"
+			kOps.LogTrail()
+			if wOK {
+				norm[key] = wVal
+			}
+			continue
+		}
+
+		switch {
+		case !hOK:
+			kOps.LogTrail()
+			msg := notice.New("expected JSON key to exist").
+				Want("%s", jsonString(wVal))
+			ers = append(ers, AddRows(kOps, msg))
+
+		case !wOK:
+			kOps.LogTrail()
+			norm[key] = hVal
+			msg := notice.New("expected JSON key not to exist").
+				Have("%s", jsonString(hVal))
+			ers = append(ers, AddRows(kOps, msg))
+
+		default:
+			var e []error
+			norm[key], e = jsonEqual(wVal, hVal, kOps)
+			ers = append(ers, e...)
+		}
+	}
+	return norm, ers
+}
+
+// jsonArray compares JSON arrays element by element. See [jsonEqual].
+func jsonArray(want, have []any, ops Options) (any, []error) {
+	if len(want) != len(have) {
+		ops.LogTrail()
+		msg := notice.New("expected JSON arrays to be equal").
+			Append("want len", "%d", len(want)).
+			Append("have len", "%d", len(have))
+		return have, []error{AddRows(ops, msg)}
 	}
 
-	if err := Equal(wantItf, haveItf, WithOptions(ops)); err != nil {
-		w, _ := json.Marshal(wantItf) // nolint:errchkjson
-		h, _ := json.Marshal(haveItf) // nolint:errchkjson
-		msg := notice.New("expected JSON strings to be equal").
-			Want("%v", string(w)).
-			Have("%v", string(h))
-		return AddRows(ops, msg)
+	var ers []error
+	norm := make([]any, len(have))
+	for i := range want {
+		var e []error
+		norm[i], e = jsonEqual(want[i], have[i], ops.PointerTrail(strconv.Itoa(i)))
+		ers = append(ers, e...)
+	}
+	return norm, ers
+}
+
+// jsonSubset recursively checks the unmarshalled JSON "want" value is
+// a subset of the "have" value building JSON Pointer trails (see
+// [Options.PointerTrail]). Returns errors for all not matching trails.
+func jsonSubset(want, have any, ops Options) []error {
+	if ok, err := jsonTrail(want, have, ops); ok {
+		if err != nil {
+			return []error{err}
+		}
+		return nil
+	}
+
+	switch w := want.(type) {
+	case map[string]any:
+		if h, ok := have.(map[string]any); ok {
+			return jsonSubsetObject(w, h, ops)
+		}
+	case []any:
+		if h, ok := have.([]any); ok {
+			if ops.UnorderedArrays {
+				return jsonSubsetUnordered(w, h, ops)
+			}
+			return jsonSubsetArray(w, h, ops)
+		}
+	}
+
+	if err := Equal(want, have, WithOptions(ops)); err != nil {
+		return []error{err}
 	}
 	return nil
 }
 
+// jsonSubsetObject checks the "want" JSON object is a subset of the "have"
+// JSON object. See [jsonSubset].
+func jsonSubsetObject(want, have map[string]any, ops Options) []error {
+	var ers []error
+	for _, key := range slices.Sorted(maps.Keys(want)) {
+		kOps := ops.PointerTrail(key)
+		hVal, ok := have[key]
+		if !ok {
+			if slices.Contains(kOps.SkipTrails, kOps.Trail) {
+				kOps.Trail += " <skipped>"
+				kOps.LogTrail()
+				continue
+			}
+			kOps.LogTrail()
+			msg := notice.New("expected JSON key to exist").
+				Want("%s", jsonString(want[key]))
+			ers = append(ers, AddRows(kOps, msg))
+			continue
+		}
+		ers = append(ers, jsonSubset(want[key], hVal, kOps)...)
+	}
+	return ers
+}
+
+// jsonSubsetArray checks the "want" JSON array elements are subsets of the
+// "have" JSON array elements with the same indexes. See [jsonSubset].
+func jsonSubsetArray(want, have []any, ops Options) []error {
+	var ers []error
+	for i := range want {
+		iOps := ops.PointerTrail(strconv.Itoa(i))
+		if i >= len(have) {
+			iOps.LogTrail()
+			msg := notice.New("expected JSON array element to exist").
+				Want("%s", jsonString(want[i]))
+			ers = append(ers, AddRows(iOps, msg))
+			continue
+		}
+		ers = append(ers, jsonSubset(want[i], have[i], iOps)...)
+	}
+	return ers
+}
+
+// jsonSubsetUnordered checks each "want" JSON array element is a subset of
+// a different "have" JSON array element regardless of its position. The trails
+// use "have" element indexes. See [jsonSubset].
+func jsonSubsetUnordered(want, have []any, ops Options) []error {
+	// Probe all pairs without logging the trails.
+	pOps := ops
+	pOps.TrailLog = nil
+	match := make([][]bool, len(want))
+	for i := range want {
+		match[i] = make([]bool, len(have))
+		for j := range have {
+			jOps := pOps.PointerTrail(strconv.Itoa(j))
+			match[i][j] = len(jsonSubset(want[i], have[j], jOps)) == 0
+		}
+	}
+
+	// Find the maximum matching using augmenting paths.
+	owner := make([]int, len(have)) // The "want" index matched to "have".
+	for j := range owner {
+		owner[j] = -1
+	}
+	var augment func(i int, seen []bool) bool
+	augment = func(i int, seen []bool) bool {
+		for j := range have {
+			if !match[i][j] || seen[j] {
+				continue
+			}
+			seen[j] = true
+			if owner[j] < 0 || augment(owner[j], seen) {
+				owner[j] = i
+				return true
+			}
+		}
+		return false
+	}
+
+	matched := make([]bool, len(want))
+	for i := range want {
+		matched[i] = augment(i, make([]bool, len(have)))
+	}
+
+	var ers []error
+	for j, i := range owner {
+		if i >= 0 {
+			_ = jsonSubset(want[i], have[j], ops.PointerTrail(strconv.Itoa(j)))
+		}
+	}
+	for i := range want {
+		if matched[i] {
+			continue
+		}
+		ops.LogTrail()
+		msg := notice.New("expected JSON array to contain element").
+			Append("index", "%d", i).
+			Want("%s", jsonString(want[i]))
+		ers = append(ers, AddRows(ops, msg))
+	}
+	return ers
+}
+
+// jsonString returns compact JSON representation of the unmarshalled value.
+func jsonString(v any) string {
+	data, _ := json.Marshal(v) // nolint:errchkjson
+	return string(data)
+}
+
+// jsonDiff returns a unified diff of pretty-printed unmarshalled JSON values.
+// Returns an empty string when any of the values cannot be marshalled.
+func jsonDiff(want, have any) string {
+	w, wErr := json.MarshalIndent(want, "", "  ")
+	h, hErr := json.MarshalIndent(have, "", "  ")
+	if wErr != nil || hErr != nil {
+		return ""
+	}
+	dif := diff.Unified("want", "have", string(w)+"\n", string(h)+"\n")
+	return strings.TrimRight(dif, "\n")
+}
+
 // toBytes converts a string or []byte value to []byte.
 func toBytes[T Text](v T) []byte {
 	if s, ok := any(v).(string); ok {
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
+	"github.com/ctx42/testing/pkg/notice"
 )
 
 func Test_JSON(t *testing.T) {
@@ -33,10 +34,303 @@ func Test_JSON(t *testing.T) {
 
 		// --- Then ---
 		affirm.NotNil(t, err)
-		wMsg := `expected JSON strings to be equal:
+		wMsg := `multiple expectations violated:
+  error: expected JSON strings to be equal
   trail: type.field
-   want: {"hello":"world"}
-   have: {"hello":"ms"}`
+   diff:
+         --- want
+         +++ have
+         @@ -1,3 +1,3 @@
+          {
+         -  "hello": "world"
+         +  "hello": "ms"
+          }
+      ---
+  error: expected values to be equal
+  trail: type.field/hello
+   want: "world"
+   have: "ms"`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("nested values not equal", func(t *testing.T) {
+		// --- Given ---
+		want := `{"items": [{"name": "a", "price": 1}, {"price": 2}]}`
+		have := `{"items": [{"name": "a", "price": 1}, {"price": 3}]}`
+
+		// --- When ---
+		err := JSON(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+  error: expected JSON strings to be equal
+   diff:
+         --- want
+         +++ have
+         @@ -5,7 +5,7 @@
+                "price": 1
+              },
+              {
+         -      "price": 2
+         +      "price": 3
+              }
+            ]
+          }
+      ---
+  error: expected values to be equal
+  trail: /items/1/price
+   want: 2
+   have: 3`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("missing and extra keys", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": 1, "b": {"c": true}}`
+		have := `{"a": 1, "d": [1, 2]}`
+
+		// --- When ---
+		err := JSON(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+  error: expected JSON strings to be equal
+   diff:
+         --- want
+         +++ have
+         @@ -1,6 +1,7 @@
+          {
+            "a": 1,
+         -  "b": {
+         +  "d": [
+         -    "c": true
+         +    1,
+         +    2
+         -  }
+         +  ]
+          }
+      ---
+  error: expected JSON key to exist
+  trail: /b
+   want: {"c":true}
+      ---
+  error: expected JSON key not to exist
+  trail: /d
+   have: [1,2]`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("arrays with different lengths", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": [1, 2]}`
+		have := `{"a": [1]}`
+
+		// --- When ---
+		err := JSON(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+     error: expected JSON strings to be equal
+      diff:
+            --- want
+            +++ have
+            @@ -1,6 +1,5 @@
+             {
+               "a": [
+            -    1,
+            -    2
+            +    1
+               ]
+             }
+         ---
+     error: expected JSON arrays to be equal
+     trail: /a
+  want len: 2
+  have len: 1`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("different types", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": "1"}`
+		have := `{"a": 1}`
+
+		// --- When ---
+		err := JSON(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+      error: expected JSON strings to be equal
+       diff:
+             --- want
+             +++ have
+             @@ -1,3 +1,3 @@
+              {
+             -  "a": "1"
+             +  "a": 1
+              }
+          ---
+      error: expected values to be equal
+      trail: /a
+  want type: string
+  have type: float64`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("escaped JSON pointer trail", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a/b": {"c~d": 1}}`
+		have := `{"a/b": {"c~d": 2}}`
+		var trails []string
+
+		// --- When ---
+		err := JSON(want, have, WithTrailLog(&trails))
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		affirm.DeepEqual(t, []string{"/a~1b/c~0d"}, trails)
+	})
+
+	t.Run("skip trail", func(t *testing.T) {
+		// --- Given ---
+		want := `{"id": 1, "items": [{"id": 2, "name": "a"}]}`
+		have := `{"id": 3, "items": [{"id": 4, "name": "a"}]}`
+		opt := WithSkipTrail("/id", "/items/0/id")
+		var trails []string
+
+		// --- When ---
+		err := JSON(want, have, opt, WithTrailLog(&trails))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		wTrails := []string{
+			"/id <skipped>",
+			"/items/0/id <skipped>",
+			"/items/0/name",
+		}
+		affirm.DeepEqual(t, wTrails, trails)
+	})
+
+	t.Run("skip trail missing in have", func(t *testing.T) {
+		// --- Given ---
+		want := `{"id": 1, "name": "a"}`
+		have := `{"name": "a", "ts": 123}`
+		opt := WithSkipTrail("/id", "/ts")
+
+		// --- When ---
+		err := JSON(want, have, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("skipped values are not in the diff", func(t *testing.T) {
+		// --- Given ---
+		want := `{"id": 1, "name": "a"}`
+		have := `{"id": 2, "name": "b"}`
+		opt := WithSkipTrail("/id")
+
+		// --- When ---
+		err := JSON(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+  error: expected JSON strings to be equal
+   diff:
+         --- want
+         +++ have
+         @@ -1,4 +1,4 @@
+          {
+            "id": 1,
+         -  "name": "a"
+         +  "name": "b"
+          }
+      ---
+  error: expected values to be equal
+  trail: /name
+   want: "a"
+   have: "b"`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("trail checker", func(t *testing.T) {
+		// --- Given ---
+		want := `{"ts": "", "name": "a"}`
+		have := `{"ts": "2026-01-02T03:04:05Z", "name": "a"}`
+		var gotWant, gotHave any
+		chk := func(want, have any, opts ...any) error {
+			gotWant, gotHave = want, have
+			return nil
+		}
+		opt := WithTrailChecker("type.field/ts", chk)
+
+		// --- When ---
+		err := JSON(want, have, opt, WithTrail("type.field"))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "", gotWant)
+		affirm.Equal(t, "2026-01-02T03:04:05Z", gotHave)
+	})
+
+	t.Run("trail checker error", func(t *testing.T) {
+		// --- Given ---
+		want := `{"ts": 1}`
+		have := `{"ts": 2}`
+		chk := func(want, have any, opts ...any) error {
+			ops := DefaultOptions(opts...)
+			return AddRows(ops, notice.New("custom error"))
+		}
+		opt := WithTrailChecker("/ts", chk)
+
+		// --- When ---
+		err := JSON(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+  error: expected JSON strings to be equal
+   diff:
+         --- want
+         +++ have
+         @@ -1,3 +1,3 @@
+          {
+         -  "ts": 1
+         +  "ts": 2
+          }
+      ---
+  error: custom error
+  trail: /ts`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("root values not equal", func(t *testing.T) {
+		// --- Given ---
+		want := `1`
+		have := `2`
+
+		// --- When ---
+		err := JSON(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+  error: expected JSON strings to be equal
+   diff:
+         --- want
+         +++ have
+         @@ -1 +1 @@
+         -1
+         +2
+      ---
+  error: expected values to be equal
+   want: 1
+   have: 2`
 		affirm.Equal(t, wMsg, err.Error())
 	})
 
@@ -115,5 +409,289 @@ func Test_JSON(t *testing.T) {
 		// --- Then ---
 		affirm.Nil(t, err)
 	})
+}
+
+func Test_JSONSubset(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := ` {"a": 1, "b": [1, {"c": true}]} `
+		have := `{"b": [1, {"c": true}], "a": 1}`
+
+		// --- When ---
+		err := JSONSubset(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("subset", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": 1, "b": [1, {"c": true}]}`
+		have := `{"a": 1, "b": [1, {"c": true, "d": 2}, 3], "e": null}`
+
+		// --- When ---
+		err := JSONSubset(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("empty object is a subset", func(t *testing.T) {
+		// --- Given ---
+		want := `{}`
+		have := `{"a": 1}`
+
+		// --- When ---
+		err := JSONSubset(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal value", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": {"b": 1}}`
+		have := `{"a": {"b": 2, "c": 3}}`
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := JSONSubset(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `expected values to be equal:
+  trail: type.field/a/b
+   want: 1
+   have: 2`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": 1, "b": {"c": [1]}}`
+		have := `{"a": 1}`
+
+		// --- When ---
+		err := JSONSubset(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `expected JSON key to exist:
+  trail: /b
+   want: {"c":[1]}`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("missing array element", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": [1, 2]}`
+		have := `{"a": [1]}`
+
+		// --- When ---
+		err := JSONSubset(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `expected JSON array element to exist:
+  trail: /a/1
+   want: 2`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("array elements in different order", func(t *testing.T) {
+		// --- Given ---
+		want := `[1, 2]`
+		have := `[2, 1]`
+
+		// --- When ---
+		err := JSONSubset(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+  error: expected values to be equal
+  trail: /0
+   want: 1
+   have: 2
+      ---
+  error: expected values to be equal
+  trail: /1
+   want: 2
+   have: 1`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("different types", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": {"b": 1}}`
+		have := `{"a": [1]}`
+
+		// --- When ---
+		err := JSONSubset(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `expected values to be equal:
+      trail: /a
+  want type: map[string]interface {}
+  have type: []interface {}`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("unordered arrays", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": [{"id": 2}, 1]}`
+		have := `{"a": [1, 3, {"id": 2, "name": "b"}]}`
+
+		// --- When ---
+		err := JSONSubset(want, have, WithUnorderedArrays())
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("unordered arrays need different elements", func(t *testing.T) {
+		// --- Given ---
+		want := `[1, 1]`
+		have := `[1, 2]`
+
+		// --- When ---
+		err := JSONSubset(want, have, WithUnorderedArrays())
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `expected JSON array to contain element:
+  index: 1
+   want: 1`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("unordered arrays find the best match", func(t *testing.T) {
+		// --- Given ---
+		want := `[{"a": 1}, {"a": 1, "b": 2}]`
+		have := `[{"a": 1, "b": 2}, {"a": 1}]`
+
+		// --- When ---
+		err := JSONSubset(want, have, WithUnorderedArrays())
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("unordered arrays missing elements", func(t *testing.T) {
+		// --- Given ---
+		want := `{"a": [3, 1, 4]}`
+		have := `{"a": [1, 2]}`
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := JSONSubset(want, have, WithUnorderedArrays(), opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+  error: expected JSON array to contain element
+  trail: type.field/a
+  index: 0
+   want: 3
+      ---
+  error: expected JSON array to contain element
+  trail: type.field/a
+  index: 2
+   want: 4`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("unordered arrays log trails of matched elements", func(t *testing.T) {
+		// --- Given ---
+		want := `[{"id": 2}, {"id": 1}]`
+		have := `[{"id": 1}, {"id": 2}]`
+		var trails []string
+		opt := WithTrailLog(&trails)
+
+		// --- When ---
+		err := JSONSubset(want, have, WithUnorderedArrays(), opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.DeepEqual(t, []string{"/0/id", "/1/id"}, trails)
+	})
 
+	t.Run("skip trail", func(t *testing.T) {
+		// --- Given ---
+		want := `{"id": 1, "items": [{"id": 2, "name": "a"}]}`
+		have := `{"items": [{"id": 4, "name": "a"}]}`
+		opt := WithSkipTrail("/id", "/items/0/id")
+		var trails []string
+
+		// --- When ---
+		err := JSONSubset(want, have, opt, WithTrailLog(&trails))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		wTrails := []string{
+			"/id <skipped>",
+			"/items/0/id <skipped>",
+			"/items/0/name",
+		}
+		affirm.DeepEqual(t, wTrails, trails)
+	})
+
+	t.Run("trail checker", func(t *testing.T) {
+		// --- Given ---
+		want := `{"ts": ""}`
+		have := `{"ts": "2026-01-02T03:04:05Z", "name": "a"}`
+		var gotWant, gotHave any
+		chk := func(want, have any, opts ...any) error {
+			gotWant, gotHave = want, have
+			return nil
+		}
+		opt := WithTrailChecker("/ts", chk)
+
+		// --- When ---
+		err := JSONSubset(want, have, opt)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+		affirm.Equal(t, "", gotWant)
+		affirm.Equal(t, "2026-01-02T03:04:05Z", gotHave)
+	})
+
+	t.Run("invalid want JSON", func(t *testing.T) {
+		// --- Given ---
+		want := `{!!!}`
+		have := `{"hello": "world"}`
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := JSONSubset(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"did not expect the unmarshalling error:\n" +
+			"     trail: type.field\n" +
+			"  argument: want\n" +
+			"     error: invalid character '!' looking for beginning of " +
+			"object key string"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("invalid have JSON", func(t *testing.T) {
+		// --- Given ---
+		want := `{"hello": "world"}`
+		have := []byte(`{!!!}`)
+
+		// --- When ---
+		err := JSONSubset(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"did not expect the unmarshalling error:\n" +
+			"  argument: have\n" +
+			"     error: invalid character '!' looking for beginning of " +
+			"object key string"
+		affirm.Equal(t, wMsg, err.Error())
+	})
 }
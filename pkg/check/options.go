@@ -10,6 +10,7 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ctx42/testing/pkg/dump"
@@ -198,6 +199,43 @@ func WithTrailChecker(trail string, chk Checker) Option {
 	}
 }
 
+// WithTransform registers a function transforming values of the given type
+// before they are compared by equality checks (used only for checks with
+// these options). Use it to normalize values, e.g., trim strings, sort
+// slices or round times. The transformed values are compared using all the
+// other options, including custom checkers (see [WithTypeChecker]) for the
+// type of the transformed values. The failure shows both original and
+// transformed values. The skipped trails (see [WithSkipTrail]) are not
+// transformed.
+//
+// Example:
+//
+//	check.Equal(want, have, check.WithTransform("", func(v any) any {
+//		return strings.ToLower(v.(string))
+//	}))
+func WithTransform(typ any, fn func(val any) any) Option {
+	return func(ops Options) Options {
+		if ops.TypeTransforms == nil {
+			ops.TypeTransforms = make(map[reflect.Type]func(val any) any)
+		}
+		ops.TypeTransforms[reflect.TypeOf(typ)] = fn
+		return ops
+	}
+}
+
+// WithTrailTransform registers a function transforming values before they are
+// compared by equality checks when the current trail exactly matches the given
+// string. It takes precedence over the type transformers. See [WithTransform].
+func WithTrailTransform(trail string, fn func(val any) any) Option {
+	return func(ops Options) Options {
+		if ops.TrailTransforms == nil {
+			ops.TrailTransforms = make(map[string]func(val any) any)
+		}
+		ops.TrailTransforms[trail] = fn
+		return ops
+	}
+}
+
 // WithSkipTrail is a [Checker] option setting trails to skip.
 func WithSkipTrail(skip ...string) Option {
 	return func(ops Options) Options {
@@ -233,6 +271,160 @@ func WithDecreasingSoft() Option {
 	}
 }
 
+// WithUnorderedSlices is a [Checker] option instructing equality checks to
+// compare all slices as multisets, ignoring the order of their elements. The
+// elements are matched using the same deep equality rules as [Equal].
+//
+// Use [WithUnorderedTrail] to ignore the order of elements only for selected
+// slices.
+func WithUnorderedSlices() Option {
+	return func(ops Options) Options {
+		ops.UnorderedSlices = true
+		return ops
+	}
+}
+
+// WithUnorderedTrail is a [Checker] option instructing equality checks to
+// compare slices at the given trails as multisets, ignoring the order of their
+// elements. See [WithUnorderedSlices].
+func WithUnorderedTrail(trails ...string) Option {
+	return func(ops Options) Options {
+		ops.UnorderedTrails = append(ops.UnorderedTrails, trails...)
+		return ops
+	}
+}
+
+// WithSliceKey is a [Checker] option instructing equality checks to align
+// elements of the slice at the given trail by the identity key returned by
+// the function (like the ID field of a struct) instead of by index. Elements
+// with the same key are compared using the index of the "want" element in
+// their trails. Elements present only in "want" or "have" are reported as
+// removed or added. The keys must be comparable and unique.
+//
+// It panics when the function is nil.
+//
+// Example:
+//
+//	check.Equal(want, have, check.WithSliceKey("Order.Items", func(e any) any {
+//		return e.(Item).ID
+//	}))
+func WithSliceKey(trail string, fn func(elem any) any) Option {
+	if fn == nil {
+		panic("cannot use a nil slice key function")
+	}
+	return func(ops Options) Options {
+		if ops.SliceKeys == nil {
+			ops.SliceKeys = make(map[string]func(elem any) any)
+		}
+		ops.SliceKeys[trail] = fn
+		return ops
+	}
+}
+
+// WithAllDiffs is a [Checker] option instructing equality checks to report
+// every difference between values. By default, slices and maps with different
+// lengths are reported as a single difference. With this option, they are
+// compared element by element, and the missing and extra elements are
+// reported. The notices are sorted by their trails. Use [WithMaxDiffs] to
+// limit the number of reported differences.
+func WithAllDiffs() Option {
+	return func(ops Options) Options {
+		ops.AllDiffs = true
+		return ops
+	}
+}
+
+// WithMaxDiffs is a [Checker] option limiting the number of differences
+// reported by equality checks with [WithAllDiffs] option. The value less or
+// equal to zero means no limit.
+func WithMaxDiffs(n int) Option {
+	return func(ops Options) Options {
+		ops.MaxDiffs = n
+		return ops
+	}
+}
+
+// WithFloatDelta is a [Checker] option instructing equality checks to treat
+// floating point and complex numbers as equal when the absolute difference
+// between them is at most the given delta.
+//
+//	|w-h| <= delta
+//
+// Use [WithFloatDeltaTrail] to set the delta only for selected trails.
+func WithFloatDelta(delta float64) Option {
+	return func(ops Options) Options {
+		ops.FloatDelta = delta
+		return ops
+	}
+}
+
+// WithFloatDeltaTrail is a [Checker] option setting the delta (see
+// [WithFloatDelta]) for the value at the given trail and all values nested in
+// it, e.g., elements of a slice or fields of a struct.
+func WithFloatDeltaTrail(trail string, delta float64) Option {
+	return func(ops Options) Options {
+		if ops.FloatDeltaTrails == nil {
+			ops.FloatDeltaTrails = make(map[string]float64)
+		}
+		ops.FloatDeltaTrails[trail] = delta
+		return ops
+	}
+}
+
+// WithFloatEpsilon is a [Checker] option instructing equality checks to treat
+// floating point and complex numbers as equal when the relative error between
+// them is at most the given epsilon.
+//
+//	|w-h|/|w| <= epsilon
+//
+// When used together with [WithFloatDelta], the numbers are equal when they
+// are within any of the tolerances. Use [WithFloatEpsilonTrail] to set the
+// epsilon only for selected trails.
+func WithFloatEpsilon(epsilon float64) Option {
+	return func(ops Options) Options {
+		ops.FloatEpsilon = epsilon
+		return ops
+	}
+}
+
+// WithFloatEpsilonTrail is a [Checker] option setting the epsilon (see
+// [WithFloatEpsilon]) for the value at the given trail and all values nested
+// in it, e.g., elements of a slice or fields of a struct.
+func WithFloatEpsilonTrail(trail string, epsilon float64) Option {
+	return func(ops Options) Options {
+		if ops.FloatEpsilonTrails == nil {
+			ops.FloatEpsilonTrails = make(map[string]float64)
+		}
+		ops.FloatEpsilonTrails[trail] = epsilon
+		return ops
+	}
+}
+
+// WithEqualMethods is a [Checker] option instructing equality checks to
+// compare values of types with "Equal(T) bool" or "Cmp(T) int" methods (like
+// net.IP or *big.Int) using those methods instead of comparing them field by
+// field. The "Equal" method takes precedence over the "Cmp" method. The
+// trail of values compared with a method has the method name suffix, e.g.,
+// "T.Addr <Equal>".
+//
+// Custom checkers (see [WithTypeChecker] and [WithTrailChecker]) take
+// precedence over the methods.
+func WithEqualMethods() Option {
+	return func(ops Options) Options {
+		ops.EqualMethods = true
+		return ops
+	}
+}
+
+// WithUnorderedArrays is an option used by [JSONSubset] check allowing "want"
+// JSON array elements to match "have" JSON array elements at any position.
+func WithUnorderedArrays() Option {
+	return func(ops Options) Options {
+		ops.UnorderedArrays = true
+		return ops
+	}
+}
+
 // WithCmpBaseTypes is a [Checker] option turning on simple base type
 // comparisons.
 //
@@ -308,14 +500,28 @@ func WithOptions(src Options) Option {
 		ops.TrailLog = src.TrailLog
 		ops.TypeCheckers = src.TypeCheckers
 		ops.TrailCheckers = src.TrailCheckers
+		ops.TypeTransforms = src.TypeTransforms
+		ops.TrailTransforms = src.TrailTransforms
 		ops.SkipTrails = src.SkipTrails
 		ops.SkipUnexported = src.SkipUnexported
+		ops.UnorderedSlices = src.UnorderedSlices
+		ops.UnorderedTrails = src.UnorderedTrails
+		ops.SliceKeys = src.SliceKeys
+		ops.AllDiffs = src.AllDiffs
+		ops.MaxDiffs = src.MaxDiffs
+		ops.FloatDelta = src.FloatDelta
+		ops.FloatDeltaTrails = src.FloatDeltaTrails
+		ops.FloatEpsilon = src.FloatEpsilon
+		ops.FloatEpsilonTrails = src.FloatEpsilonTrails
+		ops.EqualMethods = src.EqualMethods
 		ops.CmpSimpleType = src.CmpSimpleType
 		ops.IncreaseSoft = src.IncreaseSoft
 		ops.DecreaseSoft = src.DecreaseSoft
+		ops.UnorderedArrays = src.UnorderedArrays
 		ops.WaitThrottle = src.WaitThrottle
 		ops.Comment = src.Comment
 		ops.now = src.now
+		ops.transformed = src.transformed
 		return ops
 	}
 }
@@ -347,12 +553,48 @@ type Options struct {
 	// Custom checker for given trail.
 	TrailCheckers map[string]Checker
 
+	// Functions transforming values of given types before comparing them.
+	TypeTransforms map[reflect.Type]func(val any) any
+
+	// Functions transforming values at given trails before comparing them.
+	TrailTransforms map[string]func(val any) any
+
 	// List of trails to skip.
 	SkipTrails []string
 
 	// Skips all unexported fields during equality checks.
 	SkipUnexported bool
 
+	// Compare all slices ignoring the order of elements.
+	UnorderedSlices bool
+
+	// List of trails of slices to compare ignoring the order of elements.
+	UnorderedTrails []string
+
+	// Functions returning identity keys of slice elements for given trails.
+	SliceKeys map[string]func(elem any) any
+
+	// Report all differences instead of stopping at the first one.
+	AllDiffs bool
+
+	// Maximal number of differences reported with AllDiffs (0 - no limit).
+	MaxDiffs int
+
+	// Absolute tolerance when comparing floating point and complex numbers.
+	FloatDelta float64
+
+	// Absolute tolerances for given trails (and the values nested in them).
+	FloatDeltaTrails map[string]float64
+
+	// Relative tolerance when comparing floating point and complex numbers.
+	FloatEpsilon float64
+
+	// Relative tolerances for given trails (and the values nested in them).
+	FloatEpsilonTrails map[string]float64
+
+	// Compare values using their "Equal" or "Cmp" methods.
+	EqualMethods bool
+
 	// See [WithCmpBaseTypes].
 	CmpSimpleType bool
 
@@ -362,6 +604,9 @@ type Options struct {
 	// Option for [Decreasing] allowing consecutive values to be equal.
 	DecreaseSoft bool
 
+	// Option for [JSONSubset] matching array elements at any position.
+	UnorderedArrays bool
+
 	// Option for [Wait] throttling the calls to a test function.
 	WaitThrottle time.Duration
 
@@ -371,6 +616,9 @@ type Options struct {
 	// Function used to get current time. Used preliminary to inject a clock in
 	// tests of checks and assertions using [time.Now].
 	now func() time.Time
+
+	// Set when comparing already transformed values (see [WithTransform]).
+	transformed bool
 }
 
 // DefaultOptions builds an [Options] struct from the provided arguments
@@ -533,6 +781,23 @@ func (ops Options) ArrTrail(kind string, idx int) Options {
 	return ops
 }
 
+// pointerEscaper escapes JSON Pointer reference tokens.
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// PointerTrail updates [Options.Trail] with JSON Pointer (RFC 6901) reference
+// token considering already existing trail. The "~" and "/" characters in the
+// token are escaped.
+//
+// Example trails:
+//
+//	/items/3/price
+//	/a~1b
+//	field/name
+func (ops Options) PointerTrail(token string) Options {
+	ops.Trail += "/" + pointerEscaper.Replace(token)
+	return ops
+}
+
 // FieldName returns a helper function which updates [Options.Trail].
 //
 // It is useful when construction trails in custom struct checkers.
@@ -202,6 +202,47 @@ func Test_WithTrailChecker(t *testing.T) {
 	affirm.Equal(t, true, core.Same(chk, haveChk))
 }
 
+func Test_WithTransform(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		ops := Options{}
+		fn := func(val any) any { return val }
+
+		// --- When ---
+		have := WithTransform("", fn)(ops)
+
+		// --- Then ---
+		affirm.Equal(t, 1, len(have.TypeTransforms))
+		haveFn := have.TypeTransforms[reflect.TypeFor[string]()]
+		affirm.Equal(t, true, core.Same(fn, haveFn))
+	})
+
+	t.Run("add", func(t *testing.T) {
+		// --- Given ---
+		fn := func(val any) any { return val }
+		ops := WithTransform("", fn)(Options{})
+
+		// --- When ---
+		have := WithTransform(0, fn)(ops)
+
+		// --- Then ---
+		affirm.Equal(t, 2, len(have.TypeTransforms))
+	})
+}
+
+func Test_WithTrailTransform(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+	fn := func(val any) any { return val }
+
+	// --- When ---
+	have := WithTrailTransform("type.field", fn)(ops)
+
+	// --- Then ---
+	haveFn := have.TrailTransforms["type.field"]
+	affirm.Equal(t, true, core.Same(fn, haveFn))
+}
+
 func Test_WithSkipTrail(t *testing.T) {
 	// --- Given ---
 	ops := Options{}
@@ -248,6 +289,180 @@ func Test_WithDecreasingSoft(t *testing.T) {
 	affirm.Equal(t, true, have.DecreaseSoft)
 }
 
+func Test_WithUnorderedSlices(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithUnorderedSlices()(ops)
+
+	// --- Then ---
+	affirm.Equal(t, true, have.UnorderedSlices)
+}
+
+func Test_WithUnorderedTrail(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithUnorderedTrail("a", "b")(ops)
+
+	// --- Then ---
+	affirm.DeepEqual(t, []string{"a", "b"}, have.UnorderedTrails)
+}
+
+func Test_WithSliceKey(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		ops := Options{}
+		fn := func(elem any) any { return elem }
+
+		// --- When ---
+		have := WithSliceKey("a", fn)(ops)
+
+		// --- Then ---
+		affirm.Equal(t, 1, len(have.SliceKeys))
+		affirm.Equal(t, true, core.Same(fn, have.SliceKeys["a"]))
+	})
+
+	t.Run("add", func(t *testing.T) {
+		// --- Given ---
+		fn := func(elem any) any { return elem }
+		ops := WithSliceKey("a", fn)(Options{})
+
+		// --- When ---
+		have := WithSliceKey("b", fn)(ops)
+
+		// --- Then ---
+		affirm.Equal(t, 2, len(have.SliceKeys))
+	})
+
+	t.Run("panics for nil function", func(t *testing.T) {
+		// --- When ---
+		msg := affirm.Panic(t, func() { WithSliceKey("a", nil) })
+
+		// --- Then ---
+		affirm.Equal(t, "cannot use a nil slice key function", *msg)
+	})
+}
+
+func Test_WithAllDiffs(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithAllDiffs()(ops)
+
+	// --- Then ---
+	affirm.Equal(t, true, have.AllDiffs)
+}
+
+func Test_WithMaxDiffs(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithMaxDiffs(10)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 10, have.MaxDiffs)
+}
+
+func Test_WithFloatDelta(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithFloatDelta(0.1)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 0.1, have.FloatDelta)
+}
+
+func Test_WithFloatDeltaTrail(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		ops := Options{}
+
+		// --- When ---
+		have := WithFloatDeltaTrail("a", 0.1)(ops)
+
+		// --- Then ---
+		affirm.DeepEqual(t, map[string]float64{"a": 0.1}, have.FloatDeltaTrails)
+	})
+
+	t.Run("add", func(t *testing.T) {
+		// --- Given ---
+		ops := WithFloatDeltaTrail("a", 0.1)(Options{})
+
+		// --- When ---
+		have := WithFloatDeltaTrail("b", 0.2)(ops)
+
+		// --- Then ---
+		want := map[string]float64{"a": 0.1, "b": 0.2}
+		affirm.DeepEqual(t, want, have.FloatDeltaTrails)
+	})
+}
+
+func Test_WithFloatEpsilon(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithFloatEpsilon(0.1)(ops)
+
+	// --- Then ---
+	affirm.Equal(t, 0.1, have.FloatEpsilon)
+}
+
+func Test_WithFloatEpsilonTrail(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		ops := Options{}
+
+		// --- When ---
+		have := WithFloatEpsilonTrail("a", 0.1)(ops)
+
+		// --- Then ---
+		want := map[string]float64{"a": 0.1}
+		affirm.DeepEqual(t, want, have.FloatEpsilonTrails)
+	})
+
+	t.Run("add", func(t *testing.T) {
+		// --- Given ---
+		ops := WithFloatEpsilonTrail("a", 0.1)(Options{})
+
+		// --- When ---
+		have := WithFloatEpsilonTrail("b", 0.2)(ops)
+
+		// --- Then ---
+		want := map[string]float64{"a": 0.1, "b": 0.2}
+		affirm.DeepEqual(t, want, have.FloatEpsilonTrails)
+	})
+}
+
+func Test_WithEqualMethods(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithEqualMethods()(ops)
+
+	// --- Then ---
+	affirm.Equal(t, true, have.EqualMethods)
+}
+
+func Test_WithUnorderedArrays(t *testing.T) {
+	// --- Given ---
+	ops := Options{}
+
+	// --- When ---
+	have := WithUnorderedArrays()(ops)
+
+	// --- Then ---
+	affirm.Equal(t, true, have.UnorderedArrays)
+}
+
 func Test_WithCmpBaseTypes(t *testing.T) {
 	// --- Given ---
 	ops := Options{}
@@ -304,21 +519,35 @@ func Test_WithOptions(t *testing.T) {
 			Indent:   2,
 			TabWidth: 4,
 		},
-		TimeFormat:     time.RFC3339,
-		Zone:           waw,
-		Recent:         123,
-		Trail:          "trail",
-		TrailLog:       &trailLog,
-		TypeCheckers:   make(map[reflect.Type]Checker),
-		TrailCheckers:  make(map[string]Checker),
-		SkipTrails:     make([]string, 0),
-		SkipUnexported: true,
-		CmpSimpleType:  true,
-		IncreaseSoft:   true,
-		DecreaseSoft:   true,
-		WaitThrottle:   10 * time.Millisecond,
-		Comment:        "comment",
-		now:            time.Now,
+		TimeFormat:         time.RFC3339,
+		Zone:               waw,
+		Recent:             123,
+		Trail:              "trail",
+		TrailLog:           &trailLog,
+		TypeCheckers:       make(map[reflect.Type]Checker),
+		TrailCheckers:      make(map[string]Checker),
+		TypeTransforms:     make(map[reflect.Type]func(val any) any),
+		TrailTransforms:    make(map[string]func(val any) any),
+		SkipTrails:         make([]string, 0),
+		SkipUnexported:     true,
+		UnorderedSlices:    true,
+		UnorderedTrails:    make([]string, 0),
+		SliceKeys:          make(map[string]func(elem any) any),
+		AllDiffs:           true,
+		MaxDiffs:           10,
+		FloatDelta:         0.1,
+		FloatDeltaTrails:   make(map[string]float64),
+		FloatEpsilon:       0.2,
+		FloatEpsilonTrails: make(map[string]float64),
+		EqualMethods:       true,
+		CmpSimpleType:      true,
+		IncreaseSoft:       true,
+		DecreaseSoft:       true,
+		UnorderedArrays:    true,
+		WaitThrottle:       10 * time.Millisecond,
+		Comment:            "comment",
+		now:                time.Now,
+		transformed:        true,
 	}
 
 	// --- When ---
@@ -330,7 +559,16 @@ func Test_WithOptions(t *testing.T) {
 	affirm.Equal(t, true, core.Same(ops.TrailLog, have.TrailLog))
 	affirm.Equal(t, true, core.Same(ops.TypeCheckers, have.TypeCheckers))
 	affirm.Equal(t, true, core.Same(ops.TrailCheckers, have.TrailCheckers))
+	affirm.Equal(t, true, core.Same(ops.TypeTransforms, have.TypeTransforms))
+	affirm.Equal(t, true, core.Same(ops.TrailTransforms, have.TrailTransforms))
 	affirm.Equal(t, true, core.Same(ops.SkipTrails, have.SkipTrails))
+	affirm.Equal(t, true, core.Same(ops.UnorderedTrails, have.UnorderedTrails))
+	affirm.Equal(t, true, core.Same(ops.SliceKeys, have.SliceKeys))
+	affirm.Equal(t, true, core.Same(ops.FloatDeltaTrails, have.FloatDeltaTrails))
+	affirm.Equal(t, true, core.Same(
+		ops.FloatEpsilonTrails,
+		have.FloatEpsilonTrails,
+	))
 	affirm.Equal(t, true, core.Same(ops.now, have.now))
 
 	ops.now = nil
@@ -339,7 +577,7 @@ func Test_WithOptions(t *testing.T) {
 
 	// When those fail, add fields above.
 	affirm.Equal(t, 15, reflect.ValueOf(have.Dumper).NumField())
-	affirm.Equal(t, 16, reflect.ValueOf(have).NumField())
+	affirm.Equal(t, 30, reflect.ValueOf(have).NumField())
 }
 
 func Test_DefaultOptions(t *testing.T) {
@@ -361,15 +599,29 @@ func Test_DefaultOptions(t *testing.T) {
 		affirm.Equal(t, true, core.Same(Time, have.TypeCheckers[typTime]))
 		affirm.Equal(t, true, core.Same(Zone, have.TypeCheckers[typZone]))
 		affirm.Equal(t, true, core.Same(Zone, have.TypeCheckers[typZonePtr]))
+		affirm.Equal(t, true, have.TypeTransforms == nil)
+		affirm.Equal(t, true, have.TrailTransforms == nil)
 		affirm.Equal(t, true, have.SkipTrails == nil)
 		affirm.Equal(t, false, have.SkipUnexported)
+		affirm.Equal(t, false, have.UnorderedSlices)
+		affirm.Equal(t, true, have.UnorderedTrails == nil)
+		affirm.Equal(t, true, have.SliceKeys == nil)
+		affirm.Equal(t, false, have.AllDiffs)
+		affirm.Equal(t, 0, have.MaxDiffs)
+		affirm.Equal(t, 0.0, have.FloatDelta)
+		affirm.Equal(t, true, have.FloatDeltaTrails == nil)
+		affirm.Equal(t, 0.0, have.FloatEpsilon)
+		affirm.Equal(t, true, have.FloatEpsilonTrails == nil)
+		affirm.Equal(t, false, have.EqualMethods)
 		affirm.Equal(t, false, have.CmpSimpleType)
 		affirm.Equal(t, false, have.IncreaseSoft)
 		affirm.Equal(t, false, have.DecreaseSoft)
+		affirm.Equal(t, false, have.UnorderedArrays)
 		affirm.Equal(t, 10*time.Millisecond, have.WaitThrottle)
 		affirm.Equal(t, "", have.Comment)
 		affirm.Equal(t, true, core.Same(time.Now, have.now))
-		affirm.Equal(t, 16, reflect.ValueOf(have).NumField())
+		affirm.Equal(t, false, have.transformed)
+		affirm.Equal(t, 30, reflect.ValueOf(have).NumField())
 	})
 
 	t.Run("with options", func(t *testing.T) {
@@ -390,15 +642,29 @@ func Test_DefaultOptions(t *testing.T) {
 		affirm.Equal(t, true, core.Same(Zone, have.TypeCheckers[typZone]))
 		affirm.Equal(t, true, core.Same(Zone, have.TypeCheckers[typZonePtr]))
 		affirm.Equal(t, true, have.TrailCheckers == nil)
+		affirm.Equal(t, true, have.TypeTransforms == nil)
+		affirm.Equal(t, true, have.TrailTransforms == nil)
 		affirm.Equal(t, true, have.SkipTrails == nil)
 		affirm.Equal(t, false, have.SkipUnexported)
+		affirm.Equal(t, false, have.UnorderedSlices)
+		affirm.Equal(t, true, have.UnorderedTrails == nil)
+		affirm.Equal(t, true, have.SliceKeys == nil)
+		affirm.Equal(t, false, have.AllDiffs)
+		affirm.Equal(t, 0, have.MaxDiffs)
+		affirm.Equal(t, 0.0, have.FloatDelta)
+		affirm.Equal(t, true, have.FloatDeltaTrails == nil)
+		affirm.Equal(t, 0.0, have.FloatEpsilon)
+		affirm.Equal(t, true, have.FloatEpsilonTrails == nil)
+		affirm.Equal(t, false, have.EqualMethods)
 		affirm.Equal(t, false, have.CmpSimpleType)
 		affirm.Equal(t, false, have.IncreaseSoft)
 		affirm.Equal(t, false, have.DecreaseSoft)
+		affirm.Equal(t, false, have.UnorderedArrays)
 		affirm.Equal(t, 10*time.Millisecond, have.WaitThrottle)
 		affirm.Equal(t, "", have.Comment)
 		affirm.Equal(t, true, core.Same(time.Now, have.now))
-		affirm.Equal(t, 16, reflect.ValueOf(have).NumField())
+		affirm.Equal(t, false, have.transformed)
+		affirm.Equal(t, 30, reflect.ValueOf(have).NumField())
 	})
 
 	t.Run("TypeCheckers field is a clone of a global map", func(t *testing.T) {
@@ -699,6 +965,38 @@ func Test_Options_ArrTrail_tabular(t *testing.T) {
 	}
 }
 
+func Test_Options_PointerTrail_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		trail string
+		token string
+		want  string
+	}{
+		{"empty trail", "", "a", "/a"},
+		{"empty token", "", "", "/"},
+		{"index token", "/a", "1", "/a/1"},
+		{"not empty trail", "field", "a", "field/a"},
+		{"escape slash", "", "a/b", "/a~1b"},
+		{"escape tilde", "", "a~b", "/a~0b"},
+		{"escape both", "", "~/", "/~0~1"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ops := Options{Trail: tc.trail}
+
+			// --- When ---
+			have := ops.PointerTrail(tc.token)
+
+			// --- Then ---
+			affirm.Equal(t, ops.Trail, tc.trail)
+			affirm.Equal(t, tc.want, have.Trail)
+		})
+	}
+}
+
 func Test_FieldName(t *testing.T) {
 	t.Run("empty trail", func(t *testing.T) {
 		// --- Given ---
@@ -0,0 +1,455 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// JSONSchema checks that the JSON document is valid against the JSON Schema.
+// See [assert.JSONSchema].
+//
+// A practical subset of the JSON Schema draft 2020-12 is supported:
+//
+//   - boolean schemas,
+//   - "type" (a single type or a list of types),
+//   - "enum" and "const",
+//   - "properties", "required" and "additionalProperties",
+//   - "items",
+//   - "pattern" (using the [regexp] syntax),
+//   - "minimum", "maximum", "exclusiveMinimum" and "exclusiveMaximum",
+//   - "minLength", "maxLength", "minItems" and "maxItems",
+//   - "$ref" pointing to the same document (like "#/$defs/user").
+//
+// Other keywords are ignored. All violations are reported, the trails are
+// JSON Pointers to the invalid values in the document (see [JSON]), and the
+// "schema" rows point to the violated keywords in the schema.
+//
+// Example:
+//
+//	schema := `{"type": "object", "required": ["id"]}`
+//	check.JSONSchema(schema, `{"id": 1}`)
+func JSONSchema[S, D Text](schema S, doc D, opts ...any) error {
+	ops := DefaultOptions(opts...)
+	schItf, err := unmarshalJSON(toBytes(schema), "schema", ops)
+	if err != nil {
+		return err
+	}
+	docItf, err := unmarshalJSON(toBytes(doc), "document", ops)
+	if err != nil {
+		return err
+	}
+	vld := &schemaValidator{
+		root:   schItf,
+		regexp: make(map[string]*regexp.Regexp),
+		active: make(map[string]bool),
+	}
+	return notice.Join(vld.validate(schItf, "#", docItf, ops)...)
+}
+
+// schemaValidator validates unmarshalled JSON documents against the JSON
+// Schema. See [JSONSchema].
+type schemaValidator struct {
+	// Root schema used to resolve "$ref" keywords.
+	root any
+
+	// Cache of compiled "pattern" keywords.
+	regexp map[string]*regexp.Regexp
+
+	// References being resolved for document trails, used to detect cycles.
+	active map[string]bool
+}
+
+// validate validates the document value against the schema at the "loc"
+// location. Returns errors for all violations.
+func (vld *schemaValidator) validate(
+	sch any,
+	loc string,
+	doc any,
+	ops Options,
+) []error {
+
+	var obj map[string]any
+	switch s := sch.(type) {
+	case bool:
+		if s {
+			return nil
+		}
+		msg := schemaError(ops, loc, "").Have("%s", jsonString(doc))
+		return []error{msg}
+	case map[string]any:
+		obj = s
+	default:
+		cause := "schema must be an object or a boolean"
+		return []error{schemaInvalid(ops, loc, "", cause)}
+	}
+
+	var ers []error
+	if ref, ok := obj["$ref"]; ok {
+		ers = append(ers, vld.validateRef(ref, loc, doc, ops)...)
+	}
+	ers = append(ers, vld.validateType(obj, loc, doc, ops)...)
+	ers = append(ers, vld.validateEnum(obj, loc, doc, ops)...)
+
+	switch d := doc.(type) {
+	case map[string]any:
+		ers = append(ers, vld.validateObject(obj, loc, d, ops)...)
+	case []any:
+		ers = append(ers, vld.validateArray(obj, loc, d, ops)...)
+	case string:
+		ers = append(ers, vld.validateString(obj, loc, d, ops)...)
+	case float64:
+		ers = append(ers, vld.validateNumber(obj, loc, d, ops)...)
+	}
+	return ers
+}
+
+// validateRef validates the document against the schema referenced by the
+// "$ref" keyword.
+func (vld *schemaValidator) validateRef(
+	ref any,
+	loc string,
+	doc any,
+	ops Options,
+) []error {
+
+	str, _ := ref.(string)
+	if str != "#" && !strings.HasPrefix(str, "#/") {
+		cause := "only references within the schema are supported"
+		return []error{schemaInvalid(ops, loc, "$ref", cause)}
+	}
+	sch, ok := resolvePointer(vld.root, str[1:])
+	if !ok {
+		cause := fmt.Sprintf("cannot resolve %q", str)
+		return []error{schemaInvalid(ops, loc, "$ref", cause)}
+	}
+
+	key := str + " " + ops.Trail
+	if vld.active[key] {
+		cause := fmt.Sprintf("circular reference %q", str)
+		return []error{schemaInvalid(ops, loc, "$ref", cause)}
+	}
+	vld.active[key] = true
+	defer delete(vld.active, key)
+	return vld.validate(sch, str, doc, ops)
+}
+
+// validateType validates the "type" keyword.
+func (vld *schemaValidator) validateType(
+	sch map[string]any,
+	loc string,
+	doc any,
+	ops Options,
+) []error {
+
+	val, ok := sch["type"]
+	if !ok {
+		return nil
+	}
+	var types []any
+	switch v := val.(type) {
+	case string:
+		types = []any{v}
+	case []any:
+		types = v
+	}
+	for _, typ := range types {
+		if name, _ := typ.(string); jsonIsType(name, doc) {
+			return nil
+		}
+	}
+	msg := schemaError(ops, loc, "type").
+		Want("%s", jsonString(val)).
+		Have("%q", jsonType(doc))
+	return []error{msg}
+}
+
+// validateEnum validates the "enum" and "const" keywords.
+func (vld *schemaValidator) validateEnum(
+	sch map[string]any,
+	loc string,
+	doc any,
+	ops Options,
+) []error {
+
+	var ers []error
+	if val, ok := sch["enum"]; ok {
+		values, _ := val.([]any)
+		idx := slices.IndexFunc(values, func(v any) bool {
+			return reflect.DeepEqual(v, doc)
+		})
+		if idx < 0 {
+			msg := schemaError(ops, loc, "enum").
+				Want("%s", jsonString(val)).
+				Have("%s", jsonString(doc))
+			ers = append(ers, msg)
+		}
+	}
+	if val, ok := sch["const"]; ok && !reflect.DeepEqual(val, doc) {
+		msg := schemaError(ops, loc, "const").
+			Want("%s", jsonString(val)).
+			Have("%s", jsonString(doc))
+		ers = append(ers, msg)
+	}
+	return ers
+}
+
+// validateObject validates the "required", "properties" and
+// "additionalProperties" keywords.
+func (vld *schemaValidator) validateObject(
+	sch map[string]any,
+	loc string,
+	doc map[string]any,
+	ops Options,
+) []error {
+
+	var ers []error
+	required, _ := sch["required"].([]any)
+	for _, val := range required {
+		name, _ := val.(string)
+		if _, ok := doc[name]; !ok {
+			msg := schemaError(ops.PointerTrail(name), loc, "required")
+			ers = append(ers, msg)
+		}
+	}
+
+	props, _ := sch["properties"].(map[string]any)
+	additional, hasAdditional := sch["additionalProperties"]
+	for _, name := range slices.Sorted(maps.Keys(doc)) {
+		nOps := ops.PointerTrail(name)
+		if prop, ok := props[name]; ok {
+			pLoc := loc + "/properties/" + pointerEscaper.Replace(name)
+			ers = append(ers, vld.validate(prop, pLoc, doc[name], nOps)...)
+			continue
+		}
+		if !hasAdditional {
+			continue
+		}
+		if allow, ok := additional.(bool); ok && !allow {
+			msg := schemaError(nOps, loc, "additionalProperties").
+				Have("%s", jsonString(doc[name]))
+			ers = append(ers, msg)
+			continue
+		}
+		aLoc := loc + "/additionalProperties"
+		ers = append(ers, vld.validate(additional, aLoc, doc[name], nOps)...)
+	}
+	return ers
+}
+
+// validateArray validates the "items", "minItems" and "maxItems" keywords.
+func (vld *schemaValidator) validateArray(
+	sch map[string]any,
+	loc string,
+	doc []any,
+	ops Options,
+) []error {
+
+	var ers []error
+	ers = append(ers, schemaLen(sch, loc, len(doc), "Items", ops)...)
+	if items, ok := sch["items"]; ok {
+		for i, val := range doc {
+			iOps := ops.PointerTrail(strconv.Itoa(i))
+			ers = append(ers, vld.validate(items, loc+"/items", val, iOps)...)
+		}
+	}
+	return ers
+}
+
+// validateString validates the "pattern", "minLength" and "maxLength"
+// keywords.
+func (vld *schemaValidator) validateString(
+	sch map[string]any,
+	loc string,
+	doc string,
+	ops Options,
+) []error {
+
+	var ers []error
+	length := utf8.RuneCountInString(doc)
+	ers = append(ers, schemaLen(sch, loc, length, "Length", ops)...)
+	if val, ok := sch["pattern"]; ok {
+		pattern, _ := val.(string)
+		rx, err := vld.compile(pattern)
+		if err != nil {
+			msg := schemaInvalid(ops, loc, "pattern", err.Error())
+			return append(ers, msg)
+		}
+		if !rx.MatchString(doc) {
+			msg := schemaError(ops, loc, "pattern").
+				Want("%q", pattern).
+				Have("%q", doc)
+			ers = append(ers, msg)
+		}
+	}
+	return ers
+}
+
+// validateNumber validates the "minimum", "maximum", "exclusiveMinimum" and
+// "exclusiveMaximum" keywords.
+func (vld *schemaValidator) validateNumber(
+	sch map[string]any,
+	loc string,
+	doc float64,
+	ops Options,
+) []error {
+
+	limits := []struct {
+		keyword string
+		op      string
+		valid   func(limit float64) bool
+	}{
+		{"minimum", ">=", func(lim float64) bool { return doc >= lim }},
+		{"maximum", "<=", func(lim float64) bool { return doc <= lim }},
+		{"exclusiveMinimum", ">", func(lim float64) bool { return doc > lim }},
+		{"exclusiveMaximum", "<", func(lim float64) bool { return doc < lim }},
+	}
+
+	var ers []error
+	for _, lim := range limits {
+		limit, ok := sch[lim.keyword].(float64)
+		if !ok || lim.valid(limit) {
+			continue
+		}
+		msg := schemaError(ops, loc, lim.keyword).
+			Want("%s %s", lim.op, jsonString(limit)).
+			Have("%s", jsonString(doc))
+		ers = append(ers, msg)
+	}
+	return ers
+}
+
+// compile returns compiled regular expression from the cache or compiles it.
+func (vld *schemaValidator) compile(pattern string) (*regexp.Regexp, error) {
+	if rx, ok := vld.regexp[pattern]; ok {
+		return rx, nil
+	}
+	rx, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	vld.regexp[pattern] = rx
+	return rx, nil
+}
+
+// schemaLen validates the "minXXX" and "maxXXX" keywords for the given
+// suffix (like "Length" or "Items").
+func schemaLen(
+	sch map[string]any,
+	loc string,
+	length int,
+	suffix string,
+	ops Options,
+) []error {
+
+	var ers []error
+	if limit, ok := sch["min"+suffix].(float64); ok && float64(length) < limit {
+		msg := schemaError(ops, loc, "min"+suffix).
+			Append("want len", ">= %s", jsonString(limit)).
+			Append("have len", "%d", length)
+		ers = append(ers, msg)
+	}
+	if limit, ok := sch["max"+suffix].(float64); ok && float64(length) > limit {
+		msg := schemaError(ops, loc, "max"+suffix).
+			Append("want len", "<= %s", jsonString(limit)).
+			Append("have len", "%d", length)
+		ers = append(ers, msg)
+	}
+	return ers
+}
+
+// schemaError returns a notice describing the violation of the schema
+// keyword at the "loc" location. An empty keyword means the "false" schema.
+func schemaError(ops Options, loc, keyword string) *notice.Notice {
+	msg := notice.New("expected JSON to match the schema")
+	if keyword != "" {
+		_ = msg.Append("keyword", "%s", keyword)
+		loc += "/" + keyword
+	}
+	_ = msg.Append("schema", "%s", loc)
+	return AddRows(ops, msg)
+}
+
+// schemaInvalid returns a notice describing the invalid schema keyword at the
+// "loc" location.
+func schemaInvalid(ops Options, loc, keyword, cause string) *notice.Notice {
+	msg := notice.New("invalid JSON schema")
+	if keyword != "" {
+		_ = msg.Append("keyword", "%s", keyword)
+		loc += "/" + keyword
+	}
+	_ = msg.
+		Append("schema", "%s", loc).
+		Append("cause", "%s", cause)
+	return AddRows(ops, msg)
+}
+
+// resolvePointer returns the value the JSON Pointer points to in the
+// unmarshalled JSON document.
+func resolvePointer(doc any, ptr string) (any, bool) {
+	if ptr == "" {
+		return doc, true
+	}
+	if ptr[0] != '/' {
+		return nil, false
+	}
+	for _, token := range strings.Split(ptr[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch v := doc.(type) {
+		case map[string]any:
+			var ok bool
+			if doc, ok = v[token]; !ok {
+				return nil, false
+			}
+		case []any:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, false
+			}
+			doc = v[idx]
+		default:
+			return nil, false
+		}
+	}
+	return doc, true
+}
+
+// jsonType returns the JSON type name of the unmarshalled JSON value.
+func jsonType(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// jsonIsType returns true if the unmarshalled JSON value is of the given JSON
+// Schema type.
+func jsonIsType(typ string, v any) bool {
+	if typ == "integer" {
+		num, ok := v.(float64)
+		return ok && num == math.Trunc(num) && !math.IsInf(num, 0)
+	}
+	return jsonType(v) == typ
+}
@@ -0,0 +1,399 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_JSONSchema(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- Given ---
+		schema := `{
+			"$defs": {"item": {"type": "object", "required": ["name"]}},
+			"type": "object",
+			"properties": {
+				"id": {"type": "integer", "minimum": 1},
+				"name": {"type": "string", "pattern": "^[a-z]+$"},
+				"items": {"type": "array", "items": {"$ref": "#/$defs/item"}}
+			},
+			"required": ["id"],
+			"additionalProperties": {"type": "boolean"}
+		}`
+		doc := `{"id": 1, "name": "abc", "items": [{"name": "a"}], "ok": true}`
+
+		// --- When ---
+		err := JSONSchema(schema, []byte(doc))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("multiple violations", func(t *testing.T) {
+		// --- Given ---
+		schema := `{
+			"type": "object",
+			"properties": {
+				"id": {"type": "integer"},
+				"items": {"items": {"type": "object", "required": ["price"]}}
+			},
+			"required": ["id", "name"]
+		}`
+		doc := `{"id": 1.5, "items": [{"price": 1}, {}]}`
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := JSONSchema(schema, doc, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+    error: expected JSON to match the schema
+    trail: type.field/name
+  keyword: required
+   schema: #/required
+        ---
+    error: expected JSON to match the schema
+    trail: type.field/id
+  keyword: type
+   schema: #/properties/id/type
+     want: "integer"
+     have: "number"
+        ---
+    error: expected JSON to match the schema
+    trail: type.field/items/1/price
+  keyword: required
+   schema: #/properties/items/items/required`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("invalid schema JSON", func(t *testing.T) {
+		// --- When ---
+		err := JSONSchema(`{!!!}`, `{}`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"did not expect the unmarshalling error:\n" +
+			"  argument: schema\n" +
+			"     error: invalid character '!' looking for beginning of " +
+			"object key string"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("invalid document JSON", func(t *testing.T) {
+		// --- When ---
+		err := JSONSchema(`{}`, `{!!!}`)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"did not expect the unmarshalling error:\n" +
+			"  argument: document\n" +
+			"     error: invalid character '!' looking for beginning of " +
+			"object key string"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
+
+func Test_JSONSchema_success_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		schema string
+		doc    string
+	}{
+		{"true schema", `true`, `{"a": 1}`},
+		{"empty schema", `{}`, `[1, "a"]`},
+		{"type null", `{"type": "null"}`, `null`},
+		{"type boolean", `{"type": "boolean"}`, `false`},
+		{"type number", `{"type": "number"}`, `1.5`},
+		{"type integer", `{"type": "integer"}`, `2.0`},
+		{"type string", `{"type": "string"}`, `"a"`},
+		{"type array", `{"type": "array"}`, `[]`},
+		{"type object", `{"type": "object"}`, `{}`},
+		{"type list", `{"type": ["string", "null"]}`, `null`},
+		{"enum", `{"enum": [1, "a", {"b": 2}]}`, `{"b": 2}`},
+		{"const", `{"const": [1, 2]}`, `[1, 2]`},
+		{"pattern", `{"pattern": "^a+$"}`, `"aaa"`},
+		{"pattern ignored for numbers", `{"pattern": "^a$"}`, `1`},
+		{"minimum", `{"minimum": 1}`, `1`},
+		{"maximum", `{"maximum": 1}`, `1`},
+		{"exclusiveMinimum", `{"exclusiveMinimum": 1}`, `1.1`},
+		{"exclusiveMaximum", `{"exclusiveMaximum": 1}`, `0.9`},
+		{"minLength", `{"minLength": 2}`, `"żó"`},
+		{"maxLength", `{"maxLength": 2}`, `"żó"`},
+		{"minItems", `{"minItems": 1}`, `[1]`},
+		{"maxItems", `{"maxItems": 1}`, `[1]`},
+		{"additional properties", `{"additionalProperties": false}`, `{}`},
+		{"ref root", `{"items": {"$ref": "#"}, "maxItems": 1}`, `[[[]]]`},
+		{
+			"ref escaped",
+			`{"$defs": {"a/b": {"type": "null"}}, "$ref": "#/$defs/a~1b"}`,
+			`null`,
+		},
+		{"ref array", `{"$defs": [{"type": "null"}], "$ref": "#/$defs/0"}`, `null`},
+		{"unknown keyword", `{"format": "email"}`, `"abc"`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := JSONSchema(tc.schema, tc.doc)
+
+			// --- Then ---
+			affirm.Nil(t, err)
+		})
+	}
+}
+
+func Test_JSONSchema_error_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		schema string
+		doc    string
+		want   string
+	}{
+		{
+			"false schema",
+			`{"properties": {"a": false}}`,
+			`{"a": 1}`,
+			"expected JSON to match the schema:\n" +
+				"   trail: /a\n" +
+				"  schema: #/properties/a\n" +
+				"    have: 1",
+		},
+		{
+			"type",
+			`{"type": "string"}`,
+			`1`,
+			"expected JSON to match the schema:\n" +
+				"  keyword: type\n" +
+				"   schema: #/type\n" +
+				"     want: \"string\"\n" +
+				"     have: \"number\"",
+		},
+		{
+			"type integer",
+			`{"type": "integer"}`,
+			`1.5`,
+			"expected JSON to match the schema:\n" +
+				"  keyword: type\n" +
+				"   schema: #/type\n" +
+				"     want: \"integer\"\n" +
+				"     have: \"number\"",
+		},
+		{
+			"type list",
+			`{"type": ["string", "null"]}`,
+			`[]`,
+			"expected JSON to match the schema:\n" +
+				"  keyword: type\n" +
+				"   schema: #/type\n" +
+				"     want: [\"string\",\"null\"]\n" +
+				"     have: \"array\"",
+		},
+		{
+			"enum",
+			`{"enum": ["a", "b"]}`,
+			`"c"`,
+			"expected JSON to match the schema:\n" +
+				"  keyword: enum\n" +
+				"   schema: #/enum\n" +
+				"     want: [\"a\",\"b\"]\n" +
+				"     have: \"c\"",
+		},
+		{
+			"const",
+			`{"const": {"a": 1}}`,
+			`{"a": 2}`,
+			"expected JSON to match the schema:\n" +
+				"  keyword: const\n" +
+				"   schema: #/const\n" +
+				"     want: {\"a\":1}\n" +
+				"     have: {\"a\":2}",
+		},
+		{
+			"additional properties",
+			`{"properties": {"a": {}}, "additionalProperties": false}`,
+			`{"a": 1, "b": 2}`,
+			"expected JSON to match the schema:\n" +
+				"    trail: /b\n" +
+				"  keyword: additionalProperties\n" +
+				"   schema: #/additionalProperties\n" +
+				"     have: 2",
+		},
+		{
+			"additional properties schema",
+			`{"additionalProperties": {"type": "string"}}`,
+			`{"a": 1}`,
+			"expected JSON to match the schema:\n" +
+				"    trail: /a\n" +
+				"  keyword: type\n" +
+				"   schema: #/additionalProperties/type\n" +
+				"     want: \"string\"\n" +
+				"     have: \"number\"",
+		},
+		{
+			"pattern",
+			`{"pattern": "^a+$"}`,
+			`"ab"`,
+			"expected JSON to match the schema:\n" +
+				"  keyword: pattern\n" +
+				"   schema: #/pattern\n" +
+				"     want: \"^a+$\"\n" +
+				"     have: \"ab\"",
+		},
+		{
+			"minimum",
+			`{"minimum": 1}`,
+			`0.5`,
+			"expected JSON to match the schema:\n" +
+				"  keyword: minimum\n" +
+				"   schema: #/minimum\n" +
+				"     want: >= 1\n" +
+				"     have: 0.5",
+		},
+		{
+			"maximum",
+			`{"maximum": 1}`,
+			`2`,
+			"expected JSON to match the schema:\n" +
+				"  keyword: maximum\n" +
+				"   schema: #/maximum\n" +
+				"     want: <= 1\n" +
+				"     have: 2",
+		},
+		{
+			"exclusiveMinimum",
+			`{"exclusiveMinimum": 1}`,
+			`1`,
+			"expected JSON to match the schema:\n" +
+				"  keyword: exclusiveMinimum\n" +
+				"   schema: #/exclusiveMinimum\n" +
+				"     want: > 1\n" +
+				"     have: 1",
+		},
+		{
+			"exclusiveMaximum",
+			`{"exclusiveMaximum": 1}`,
+			`1`,
+			"expected JSON to match the schema:\n" +
+				"  keyword: exclusiveMaximum\n" +
+				"   schema: #/exclusiveMaximum\n" +
+				"     want: < 1\n" +
+				"     have: 1",
+		},
+		{
+			"minLength",
+			`{"minLength": 3}`,
+			`"żó"`,
+			"expected JSON to match the schema:\n" +
+				"   keyword: minLength\n" +
+				"    schema: #/minLength\n" +
+				"  want len: >= 3\n" +
+				"  have len: 2",
+		},
+		{
+			"maxLength",
+			`{"maxLength": 1}`,
+			`"żó"`,
+			"expected JSON to match the schema:\n" +
+				"   keyword: maxLength\n" +
+				"    schema: #/maxLength\n" +
+				"  want len: <= 1\n" +
+				"  have len: 2",
+		},
+		{
+			"minItems",
+			`{"minItems": 1}`,
+			`[]`,
+			"expected JSON to match the schema:\n" +
+				"   keyword: minItems\n" +
+				"    schema: #/minItems\n" +
+				"  want len: >= 1\n" +
+				"  have len: 0",
+		},
+		{
+			"maxItems",
+			`{"maxItems": 1}`,
+			`[1, 2]`,
+			"expected JSON to match the schema:\n" +
+				"   keyword: maxItems\n" +
+				"    schema: #/maxItems\n" +
+				"  want len: <= 1\n" +
+				"  have len: 2",
+		},
+		{
+			"ref",
+			`{"$defs": {"i": {"type": "integer"}}, "items": {"$ref": "#/$defs/i"}}`,
+			`[1, "a"]`,
+			"expected JSON to match the schema:\n" +
+				"    trail: /1\n" +
+				"  keyword: type\n" +
+				"   schema: #/$defs/i/type\n" +
+				"     want: \"integer\"\n" +
+				"     have: \"string\"",
+		},
+		{
+			"invalid schema",
+			`{"items": 1}`,
+			`[1]`,
+			"invalid JSON schema:\n" +
+				"   trail: /0\n" +
+				"  schema: #/items\n" +
+				"   cause: schema must be an object or a boolean",
+		},
+		{
+			"invalid pattern",
+			`{"pattern": "[a"}`,
+			`"a"`,
+			"invalid JSON schema:\n" +
+				"  keyword: pattern\n" +
+				"   schema: #/pattern\n" +
+				"    cause: error parsing regexp: missing closing ]: `[a`",
+		},
+		{
+			"external ref",
+			`{"$ref": "other.json#/a"}`,
+			`1`,
+			"invalid JSON schema:\n" +
+				"  keyword: $ref\n" +
+				"   schema: #/$ref\n" +
+				"    cause: only references within the schema are supported",
+		},
+		{
+			"not existing ref",
+			`{"$ref": "#/$defs/a"}`,
+			`1`,
+			"invalid JSON schema:\n" +
+				"  keyword: $ref\n" +
+				"   schema: #/$ref\n" +
+				"    cause: cannot resolve \"#/$defs/a\"",
+		},
+		{
+			"circular ref",
+			`{"$defs": {"a": {"$ref": "#"}}, "$ref": "#/$defs/a"}`,
+			`1`,
+			"invalid JSON schema:\n" +
+				"  keyword: $ref\n" +
+				"   schema: #/$ref\n" +
+				"    cause: circular reference \"#/$defs/a\"",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := JSONSchema(tc.schema, tc.doc)
+
+			// --- Then ---
+			affirm.NotNil(t, err)
+			affirm.Equal(t, tc.want, err.Error())
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"github.com/ctx42/testing/internal/yaml"
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// YAML checks that two YAML texts are equivalent (after parsing). Key order,
+// quoting, comments and formatting do not matter. See [assert.YAML].
+//
+// Block and flow mappings and sequences, plain and quoted scalars, block
+// scalars and comments are supported. Anchors, aliases, tags, and multiple
+// documents are not.
+//
+// The failures are reported the same way as in [JSON], the unified diff shows
+// the documents in the JSON form, and the trails are JSON Pointers.
+//
+// Example:
+//
+//	check.YAML("a: 1\nb: [x, y]", "b:\n  - x\n  - y\na: 1")
+func YAML[W, H Text](want W, have H, opts ...any) error {
+	ops := DefaultOptions(opts...)
+	wantItf, err := parseYAML(toBytes(want), "want", ops)
+	if err != nil {
+		return err
+	}
+	haveItf, err := parseYAML(toBytes(have), "have", ops)
+	if err != nil {
+		return err
+	}
+	return docEqual("expected YAML strings to be equal", wantItf, haveItf, ops)
+}
+
+// parseYAML parses YAML text. The "arg" is the name of the argument reported
+// in the parsing error.
+func parseYAML(data []byte, arg string, ops Options) (any, error) {
+	itf, err := yaml.Parse(data)
+	if err != nil {
+		msg := notice.New("did not expect the parsing error").
+			Append("argument", "%s", arg).
+			Append("error", "%s", err)
+		return nil, AddRows(ops, msg)
+	}
+	return itf, nil
+}
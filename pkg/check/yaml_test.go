@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package check
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/internal/affirm"
+)
+
+func Test_YAML(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		want := "" +
+			"# Comment.\n" +
+			"a: 1\n" +
+			"b: [x, 'y']\n" +
+			"c: {d: true}\n"
+		have := "" +
+			"c:\n" +
+			"  d: true\n" +
+			"b:\n" +
+			"  - \"x\"\n" +
+			"  - y\n" +
+			"a: 1.0\n"
+
+		// --- When ---
+		err := YAML(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("equal bytes", func(t *testing.T) {
+		// --- Given ---
+		want := []byte("a: 1")
+		have := []byte("{a: 1}")
+
+		// --- When ---
+		err := YAML(want, have)
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("empty documents", func(t *testing.T) {
+		// --- When ---
+		err := YAML("", "# Comment.")
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		want := "a: 1\nb: [x, y]\n"
+		have := "b: [x, z]\na: 1\n"
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := YAML(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+  error: expected YAML strings to be equal
+  trail: type.field
+   diff:
+         --- want
+         +++ have
+         @@ -2,6 +2,6 @@
+            "a": 1,
+            "b": [
+              "x",
+         -    "y"
+         +    "z"
+            ]
+          }
+      ---
+  error: expected values to be equal
+  trail: type.field/b/1
+   want: "y"
+   have: "z"`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("different scalar types", func(t *testing.T) {
+		// --- Given ---
+		want := "a: 1"
+		have := "a: '1'"
+
+		// --- When ---
+		err := YAML(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := `multiple expectations violated:
+      error: expected YAML strings to be equal
+       diff:
+             --- want
+             +++ have
+             @@ -1,3 +1,3 @@
+              {
+             -  "a": 1
+             +  "a": "1"
+              }
+          ---
+      error: expected values to be equal
+      trail: /a
+  want type: float64
+  have type: string`
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("skip trail", func(t *testing.T) {
+		// --- Given ---
+		want := "id: 1\nname: a\n"
+		have := "id: 2\nname: a\n"
+
+		// --- When ---
+		err := YAML(want, have, WithSkipTrail("/id"))
+
+		// --- Then ---
+		affirm.Nil(t, err)
+	})
+
+	t.Run("invalid want YAML", func(t *testing.T) {
+		// --- Given ---
+		want := "a: [1"
+		have := "a: 1"
+		opt := WithTrail("type.field")
+
+		// --- When ---
+		err := YAML(want, have, opt)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"did not expect the parsing error:\n" +
+			"     trail: type.field\n" +
+			"  argument: want\n" +
+			"     error: yaml: syntax error: line 1: unterminated flow " +
+			"collection"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+
+	t.Run("invalid have YAML", func(t *testing.T) {
+		// --- Given ---
+		want := "a: 1"
+		have := []byte("a: [1")
+
+		// --- When ---
+		err := YAML(want, have)
+
+		// --- Then ---
+		affirm.NotNil(t, err)
+		wMsg := "" +
+			"did not expect the parsing error:\n" +
+			"  argument: have\n" +
+			"     error: yaml: syntax error: line 1: unterminated flow " +
+			"collection"
+		affirm.Equal(t, wMsg, err.Error())
+	})
+}
@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	"github.com/ctx42/testing/internal/affirm"
-	"github.com/ctx42/testing/pkg/goldy"
 	"github.com/ctx42/testing/pkg/testcases"
 )
 
@@ -81,7 +80,7 @@ func Test_MapDumper_tabular(t *testing.T) {
 			"default map[int]testcases.TRec",
 			New(WithTimeFormat(TimeAsUnix)),
 			map[int]testcases.TRec{0: {Int: 0}, 1: {Int: 1}},
-			goldy.Open(t, "testdata/map_of_structs.gld").String(),
+			golden(t, "testdata/map_of_structs.gld"),
 		},
 	}
 
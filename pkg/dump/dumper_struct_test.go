@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/ctx42/testing/internal/affirm"
-	"github.com/ctx42/testing/pkg/goldy"
 	"github.com/ctx42/testing/pkg/testcases"
 )
 
@@ -30,8 +29,8 @@ func Test_StructDumper(t *testing.T) {
 		have := StructDumper(dmp, 0, reflect.ValueOf(s))
 
 		// --- Then ---
-		want := goldy.Open(t, "testdata/struct_simple.gld")
-		affirm.Equal(t, want.String(), have)
+		want := golden(t, "testdata/struct_simple.gld")
+		affirm.Equal(t, want, have)
 	})
 
 	t.Run("simple struct without private fields", func(t *testing.T) {
@@ -50,8 +49,8 @@ func Test_StructDumper(t *testing.T) {
 		have := StructDumper(dmp, 0, reflect.ValueOf(s))
 
 		// --- Then ---
-		want := goldy.Open(t, "testdata/struct_simple_no_private.gld")
-		affirm.Equal(t, want.String(), have)
+		want := golden(t, "testdata/struct_simple_no_private.gld")
+		affirm.Equal(t, want, have)
 	})
 
 	t.Run("simple flat & compact struct", func(t *testing.T) {
@@ -70,8 +69,8 @@ func Test_StructDumper(t *testing.T) {
 		have := StructDumper(dmp, 0, reflect.ValueOf(s))
 
 		// --- Then ---
-		want := goldy.Open(t, "testdata/struct_simple_flat_compact.gld")
-		affirm.Equal(t, want.String(), have)
+		want := golden(t, "testdata/struct_simple_flat_compact.gld")
+		affirm.Equal(t, want, have)
 	})
 
 	t.Run("multi level struct", func(t *testing.T) {
@@ -88,8 +87,8 @@ func Test_StructDumper(t *testing.T) {
 		have := StructDumper(dmp, 0, reflect.ValueOf(s))
 
 		// --- Then ---
-		want := goldy.Open(t, "testdata/struct_multi_level.gld")
-		affirm.Equal(t, want.String(), have)
+		want := golden(t, "testdata/struct_multi_level.gld")
+		affirm.Equal(t, want, have)
 	})
 
 	t.Run("multi-level struct with indent", func(t *testing.T) {
@@ -106,8 +105,8 @@ func Test_StructDumper(t *testing.T) {
 		have := StructDumper(dmp, 0, reflect.ValueOf(s))
 
 		// --- Then ---
-		want := goldy.Open(t, "testdata/struct_multi_level_indent.gld")
-		affirm.Equal(t, want.String(), have)
+		want := golden(t, "testdata/struct_multi_level_indent.gld")
+		affirm.Equal(t, want, have)
 	})
 
 	t.Run("multi-level flat & compact struct", func(t *testing.T) {
@@ -124,8 +123,8 @@ func Test_StructDumper(t *testing.T) {
 		have := StructDumper(dmp, 0, reflect.ValueOf(s))
 
 		// --- Then ---
-		want := goldy.Open(t, "testdata/struct_multi_level_flat_compact.gld")
-		affirm.Equal(t, want.String(), have)
+		want := golden(t, "testdata/struct_multi_level_flat_compact.gld")
+		affirm.Equal(t, want, have)
 	})
 
 	t.Run("struct with a multiline string field value", func(t *testing.T) {
@@ -143,8 +142,8 @@ func Test_StructDumper(t *testing.T) {
 		have := StructDumper(dmp, 0, reflect.ValueOf(s))
 
 		// --- Then ---
-		want := goldy.Open(t, "testdata/struct_multi_line_string_field.gld")
-		affirm.Equal(t, want.String(), have)
+		want := golden(t, "testdata/struct_multi_line_string_field.gld")
+		affirm.Equal(t, want, have)
 	})
 
 	t.Run("error - invalid type", func(t *testing.T) {
@@ -11,6 +11,12 @@
 // Golden files are typically stored under testdata/ and committed.
 // The package integrates with [tester.T] for failure reporting.
 //
+// Use [Goldy.Assert] (or [Assert]) to compare generated output with the
+// golden file content. When the update mode is on (see [Update]), mismatching
+// golden files are rewritten instead of failing the test, which makes
+// regenerating many golden files after an intentional change a single
+// "go test ./... -goldy.update" run.
+//
 // See the package [README] and examples for typical usage with
 // [github.com/ctx42/testing/pkg/assert] and [github.com/ctx42/testing/pkg/check].
 package goldy
@@ -19,18 +25,41 @@ import (
 	"bufio"
 	"bytes"
 	"errors"
+	"flag"
 	"io"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/notice"
 	"github.com/ctx42/testing/pkg/tester"
 )
 
 // Marker is a separator between a golden file comment and the content.
 const Marker = "---\n"
 
+// UpdateEnv is the name of the environment variable turning on the golden
+// file update mode when set to a true value (see [strconv.ParseBool]).
+const UpdateEnv = "GOLDY_UPDATE"
+
+// update is the "-goldy.update" test flag turning on the golden file update
+// mode.
+var update = flag.Bool("goldy.update", false, "update golden files")
+
+// Update reports whether the golden file update mode is on. The mode is
+// turned on by the "-goldy.update" test flag or the [UpdateEnv] environment
+// variable.
+func Update() bool {
+	if *update {
+		return true
+	}
+	on, _ := strconv.ParseBool(os.Getenv(UpdateEnv))
+	return on
+}
+
 // WithData is the [Open] option setting [Goldy] data for golden files which
 // are text templates.
 func WithData(data map[string]any) func(*Goldy) {
@@ -136,6 +165,48 @@ func Create(t tester.T, pth string) *Goldy {
 	}
 }
 
+// Assert checks the content of the golden file at pth equals "have". It is
+// a shorthand for [Open] followed by [Goldy.Assert]. In the update mode, when
+// the golden file does not exist, it is created with an empty comment.
+//
+// The options of type func(*Goldy) (like [WithData]) are passed to [Open],
+// all the other options are passed to [check.Equal].
+//
+// Returns true when the content matches or the file was updated.
+func Assert(t tester.T, pth, have string, opts ...any) bool {
+	t.Helper()
+
+	var gldOpts []func(*Goldy)
+	var chkOpts []any
+	for _, opt := range opts {
+		if fn, ok := opt.(func(*Goldy)); ok {
+			gldOpts = append(gldOpts, fn)
+			continue
+		}
+		chkOpts = append(chkOpts, opt)
+	}
+
+	if Update() {
+		if _, err := os.Stat(pth); errors.Is(err, os.ErrNotExist) {
+			gld := Create(t, pth)
+			if gld == nil {
+				return false
+			}
+			gld.content = []byte(have)
+			if !gld.Save() {
+				return false
+			}
+			t.Logf("created golden file: %s", pth)
+			return true
+		}
+	}
+	gld := Open(t, pth, gldOpts...)
+	if gld == nil {
+		return false
+	}
+	return gld.Assert(have, chkOpts...)
+}
+
 // String implements [fmt.Stringer] and returns the golden file content.
 func (gld *Goldy) String() string { return string(gld.content) }
 
@@ -163,8 +234,9 @@ func (gld *Goldy) SetContent(content string) *Goldy {
 }
 
 // Save writes the golden file (comment + [Marker] + content) back to its
-// original path. It reports errors via the test's t.Error.
-func (gld *Goldy) Save() {
+// original path. It reports errors via the test's t.Error and returns false,
+// otherwise it returns true.
+func (gld *Goldy) Save() bool {
 	gld.t.Helper()
 
 	buf := &bytes.Buffer{}
@@ -181,7 +253,46 @@ func (gld *Goldy) Save() {
 	}
 	if err := os.WriteFile(gld.pth, buf.Bytes(), 0600); err != nil {
 		gld.t.Errorf("error writing golden file (%s): %v", gld.pth, err)
+		return false
+	}
+	return true
+}
+
+// Assert checks the golden file content equals "have" using [check.Equal]
+// with the given options. On mismatch, it reports the difference via the
+// test's t.Error and returns false.
+//
+// In the update mode (see [Update]), instead of failing, the mismatching
+// golden file content is replaced with "have" and saved, preserving the
+// comment section and the [Marker] separator. Rewritten files are reported
+// with t.Log so they can be reviewed. Golden file templates (see [WithData])
+// cannot be updated.
+func (gld *Goldy) Assert(have string, opts ...any) bool {
+	gld.t.Helper()
+
+	err := check.Equal(string(gld.content), have, opts...)
+	if err == nil {
+		return true
+	}
+
+	if Update() {
+		if gld.data != nil {
+			gld.t.Errorf("cannot update golden file template (%s)", gld.pth)
+			return false
+		}
+		gld.content = []byte(have)
+		if !gld.Save() {
+			return false
+		}
+		gld.t.Logf("updated golden file: %s", gld.pth)
+		return true
 	}
+
+	msg := notice.From(err).
+		SetHeader("expected golden file content to be equal").
+		Prepend("file", "%s", gld.pth)
+	gld.t.Error(msg)
+	return false
 }
 
 // renderTemplate renders golden file content as a text template using data
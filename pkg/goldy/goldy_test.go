@@ -10,10 +10,59 @@ import (
 
 	"github.com/ctx42/testing/internal/affirm"
 	"github.com/ctx42/testing/internal/core"
+	"github.com/ctx42/testing/pkg/check"
 	"github.com/ctx42/testing/pkg/must"
 	"github.com/ctx42/testing/pkg/tester"
 )
 
+func Test_Update(t *testing.T) {
+	t.Run("off by default", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "")
+
+		// --- When ---
+		have := Update()
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("turned on by the flag", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "")
+		*update = true
+		defer func() { *update = false }()
+
+		// --- When ---
+		have := Update()
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("turned on by the environment variable", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "1")
+
+		// --- When ---
+		have := Update()
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("invalid environment variable value", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "abc")
+
+		// --- When ---
+		have := Update()
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
 func Test_WithData(t *testing.T) {
 	// --- Given ---
 	data := map[string]any{"A": 1}
@@ -244,6 +293,132 @@ func Test_Create(t *testing.T) {
 	})
 }
 
+func Test_Assert(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "")
+		tspy := tester.New(t)
+		tspy.Close()
+
+		// --- When ---
+		have := Assert(tspy, "testdata/test_case1.gld", "Content #1.\nContent #2.")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "")
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("expected golden file content to be equal")
+		tspy.Close()
+
+		// --- When ---
+		have := Assert(tspy, "testdata/test_case1.gld", "Content #1.")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - with open and check options", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "")
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected golden file content to be equal:\n" +
+			"     file: testdata/test_tpl.gld\n" +
+			"  comment: golden\n" +
+			"     want: \"Content #1.\"\n" +
+			"     have: \"Content #2.\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		data := WithData(map[string]any{"first": 1})
+		opt := check.WithComment("golden")
+
+		// --- When ---
+		have := Assert(tspy, "testdata/test_tpl.gld", "Content #2.", data, opt)
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - not existing file", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "")
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "error opening file: open testdata/not-existing.gld: " +
+			"no such file or directory"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := Assert(tspy, "testdata/not-existing.gld", "Content #1.")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("update mode - creates not existing file", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "1")
+		pth := filepath.Join(t.TempDir(), "golden.gld")
+
+		tspy := tester.New(t)
+		tspy.ExpectLogEqual("created golden file: %s", pth)
+		tspy.Close()
+
+		// --- When ---
+		have := Assert(tspy, pth, "")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		content := must.Value(os.ReadFile(pth))
+		affirm.Equal(t, "\n---\n", string(content))
+	})
+
+	t.Run("update mode - updates existing file", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "1")
+		pth := filepath.Join(t.TempDir(), "golden.gld")
+		must.Nil(os.WriteFile(pth, []byte("comment\n---\nabc"), 0600))
+
+		tspy := tester.New(t)
+		tspy.ExpectLogEqual("updated golden file: %s", pth)
+		tspy.Close()
+
+		// --- When ---
+		have := Assert(tspy, pth, "xyz")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		content := must.Value(os.ReadFile(pth))
+		affirm.Equal(t, "comment\n---\nxyz", string(content))
+	})
+
+	t.Run("update mode - error - cannot create file", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "1")
+		pth := filepath.Join(t.TempDir(), "not-existing-dir", "golden.gld")
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "error creating file: open " + pth +
+			": no such file or directory"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := Assert(tspy, pth, "xyz")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
 func Test_Goldy_String(t *testing.T) {
 	// --- Given ---
 	gld := &Goldy{content: []byte("content")}
@@ -337,13 +512,14 @@ func Test_Goldy_Save(t *testing.T) {
 		}
 
 		// --- When ---
-		gld.Save()
+		have := gld.Save()
 
 		// --- Then ---
+		affirm.Equal(t, true, have)
 		affirm.Equal(t, false, tspy.Failed())
-		have := must.Value(os.ReadFile(pth))
+		got := must.Value(os.ReadFile(pth))
 		want := "Comment 1.\nComment 2.\n---\ncontent 1\ncontent 2\n"
-		affirm.Equal(t, want, string(have))
+		affirm.Equal(t, want, string(got))
 	})
 
 	t.Run("when a template", func(t *testing.T) {
@@ -362,13 +538,14 @@ func Test_Goldy_Save(t *testing.T) {
 		}
 
 		// --- When ---
-		gld.Save()
+		have := gld.Save()
 
 		// --- Then ---
+		affirm.Equal(t, true, have)
 		affirm.Equal(t, false, tspy.Failed())
-		have := must.Value(os.ReadFile(pth))
+		got := must.Value(os.ReadFile(pth))
 		want := "comment\n---\ncontent {{ .first }}"
-		affirm.Equal(t, want, string(have))
+		affirm.Equal(t, want, string(got))
 	})
 
 	t.Run("comment lines do not end with a new line", func(t *testing.T) {
@@ -385,13 +562,14 @@ func Test_Goldy_Save(t *testing.T) {
 		}
 
 		// --- When ---
-		gld.Save()
+		have := gld.Save()
 
 		// --- Then ---
+		affirm.Equal(t, true, have)
 		affirm.Equal(t, false, tspy.Failed())
-		have := must.Value(os.ReadFile(pth))
+		got := must.Value(os.ReadFile(pth))
 		want := "Comment 1.\nComment 2.\n---\ncontent 1\ncontent 2\n"
-		affirm.Equal(t, want, string(have))
+		affirm.Equal(t, want, string(got))
 	})
 
 	t.Run("error - when the file cannot be written", func(t *testing.T) {
@@ -412,7 +590,188 @@ func Test_Goldy_Save(t *testing.T) {
 		}
 
 		// --- When ---
-		gld.Save()
+		have := gld.Save()
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+}
+
+func Test_Goldy_Assert(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "")
+		tspy := tester.New(t)
+		tspy.Close()
+
+		gld := Open(tspy, "testdata/test_case2.gld")
+
+		// --- When ---
+		have := gld.Assert("Content #1.\nContent #2.\n")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+	})
+
+	t.Run("error - not equal single line", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "")
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected golden file content to be equal:\n" +
+			"  file: testdata/test_no_new_line.gld\n" +
+			"  want: \"abc\"\n" +
+			"  have: \"xyz\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		gld := &Goldy{
+			pth:     "testdata/test_no_new_line.gld",
+			content: []byte("abc"),
+			t:       tspy,
+		}
+
+		// --- When ---
+		have := gld.Assert("xyz")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - not equal multi line", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "")
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected golden file content to be equal:\n" +
+			"  file: testdata/test_case2.gld\n" +
+			"  want: \"Content #1.\\nContent #2.\\n\"\n" +
+			"  have: \"Content #1.\\nContent #3.\\n\"\n" +
+			"  diff:\n" +
+			"        @@ -1,2 +1,2 @@\n" +
+			"         Content #1.\n" +
+			"        -Content #3.\n" +
+			"        +Content #2."
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		gld := Open(tspy, "testdata/test_case2.gld")
+
+		// --- When ---
+		have := gld.Assert("Content #1.\nContent #3.\n")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("error - with check options", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "")
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected golden file content to be equal:\n" +
+			"     file: testdata/test_no_new_line.gld\n" +
+			"  comment: golden\n" +
+			"     want: \"abc\"\n" +
+			"     have: \"xyz\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		gld := &Goldy{
+			pth:     "testdata/test_no_new_line.gld",
+			content: []byte("abc"),
+			t:       tspy,
+		}
+
+		// --- When ---
+		have := gld.Assert("xyz", check.WithComment("golden"))
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("update mode - equal", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "1")
+		pth := filepath.Join(t.TempDir(), "golden.gld")
+		must.Nil(os.WriteFile(pth, []byte("comment\n---\nabc"), 0600))
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		gld := Open(tspy, pth)
+
+		// --- When ---
+		have := gld.Assert("abc")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		stat := must.Value(os.Stat(pth))
+		affirm.Equal(t, int64(len("comment\n---\nabc")), stat.Size())
+	})
+
+	t.Run("update mode - preserves comment", func(t *testing.T) {
+		// --- Given ---
+		*update = true
+		defer func() { *update = false }()
+
+		pth := filepath.Join(t.TempDir(), "golden.gld")
+		content := "Multiple\ncomment\nlines.\n---\nabc\n"
+		must.Nil(os.WriteFile(pth, []byte(content), 0600))
+
+		tspy := tester.New(t)
+		tspy.ExpectLogEqual("updated golden file: %s", pth)
+		tspy.Close()
+
+		gld := Open(tspy, pth)
+
+		// --- When ---
+		have := gld.Assert("xyz\n")
+
+		// --- Then ---
+		affirm.Equal(t, true, have)
+		affirm.Equal(t, "xyz\n", gld.String())
+		got := must.Value(os.ReadFile(pth))
+		affirm.Equal(t, "Multiple\ncomment\nlines.\n---\nxyz\n", string(got))
+	})
+
+	t.Run("update mode - error - cannot write file", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "1")
+		pth := filepath.Join(t.TempDir(), "sub-dir", "golden.gld")
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "error writing golden file (" + pth + "): open " + pth +
+			": no such file or directory"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		gld := &Goldy{pth: pth, content: []byte("abc"), t: tspy}
+
+		// --- When ---
+		have := gld.Assert("xyz")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
+	})
+
+	t.Run("update mode - error - template", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(UpdateEnv, "1")
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "cannot update golden file template (testdata/test_tpl.gld)"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		data := WithData(map[string]any{"first": 1})
+		gld := Open(tspy, "testdata/test_tpl.gld", data)
+
+		// --- When ---
+		have := gld.Assert("Content #2.")
+
+		// --- Then ---
+		affirm.Equal(t, false, have)
 	})
 }
 
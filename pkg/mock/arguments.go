@@ -76,6 +76,9 @@ func (args Arguments) Diff(vs []any) ([]string, int) {
 
 		if len(args) > i {
 			want = args[i]
+			if cpt, ok := want.(capturer); ok {
+				want = cpt.matcher()
+			}
 			if want == Any {
 				wantFmt = "(any=mock.Any)"
 			} else if _, ok := have.(context.Context); ok {
@@ -107,6 +110,11 @@ func (args Arguments) Diff(vs []any) ([]string, int) {
 
 			diffCnt++
 			msg := formatFail(i, am.Desc()+panicMsg, haveFmt)
+			if am.why != nil && panicMsg == "" {
+				for _, line := range am.why(have) {
+					msg += "\n    " + line
+				}
+			}
 			out = append(out, msg)
 			continue
 		}
@@ -84,6 +84,43 @@ func Test_Arguments_Diff(t *testing.T) {
 		assert.Equal(t, want, have)
 	})
 
+	t.Run("captor", func(t *testing.T) {
+		// --- Given ---
+		cpt := Capture[int]()
+		wantA := []any{cpt, cpt}
+		haveA := []any{42, "str"}
+
+		// --- When ---
+		have, cnt := Arguments(wantA).Diff(haveA)
+
+		// --- Then ---
+		assert.Equal(t, 1, cnt)
+		want := []string{
+			`0: PASS: [mock.Capture=int] == (int=42)`,
+			`1: FAIL: [mock.Capture=int] != (string="str")`,
+		}
+		assert.Equal(t, want, have)
+		assert.Nil(t, cpt.All())
+	})
+
+	t.Run("composed matchers", func(t *testing.T) {
+		// --- Given ---
+		wantA := []any{MatchAll(AnyString, MatchLen(3)), MatchNot(AnyInt)}
+		haveA := []any{"abcd", true}
+
+		// --- When ---
+		have, cnt := Arguments(wantA).Diff(haveA)
+
+		// --- Then ---
+		assert.Equal(t, 1, cnt)
+		want := []string{
+			"0: FAIL: [mock.MatchAll=[mock.MatchOfType=string] && " +
+				`[mock.MatchLen=3]] != (string="abcd")`,
+			"1: PASS: [mock.MatchNot=[mock.MatchOfType=int]] == (bool=true)",
+		}
+		assert.Equal(t, want, have)
+	})
+
 	t.Run("not matching two out of three", func(t *testing.T) {
 		// --- Given ---
 		wantA := []any{"str", 42, true}
@@ -4,8 +4,10 @@
 package mock
 
 import (
+	"context"
 	"errors"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -46,6 +48,14 @@ type Call struct {
 	// Arguments to return when this method is called.
 	returns Arguments
 
+	// Arguments to return from consecutive calls. When the method is called
+	// more times than there are elements, the last one is returned.
+	returnSeq []Arguments
+
+	// Computes arguments to return from the arguments the method was called
+	// with.
+	returnFn func(Arguments) Arguments
+
 	// Maximum number of times the method can be called. Zero means no
 	// restrictions.
 	wantCalls int
@@ -64,6 +74,10 @@ type Call struct {
 	// for a given period of time.
 	after time.Duration
 
+	// When set, blocking calls return early with values computed by the
+	// function when the context argument is cancelled.
+	ctxFn func(ctx context.Context) Arguments
+
 	// Change arguments passed to the mocked method during its execution. The
 	// functions are called on the arguments right before returning.
 	alter []func(Arguments)
@@ -76,9 +90,16 @@ type Call struct {
 	// ones that return true from the [Call.Satisfied] method.
 	requires []*Call
 
+	// Sequence the call belongs to. If nil, the call can be made in any order.
+	seq *Sequence
+
 	// The actual method to call.
 	proxy reflect.Value
 
+	// Closed and replaced every time the number of calls changes. Used to
+	// wake up goroutines waiting for the call (see [Call.WaitSatisfied]).
+	changed chan struct{}
+
 	// Guards the fields.
 	mx sync.Mutex
 }
@@ -133,11 +154,66 @@ func (c *Call) With(args ...any) *Call {
 // Return sets the values that will be returned when the mocked method is
 // later invoked. It panics if called on a proxy call (proxies forward real
 // return values).
+//
+// It replaces values set with [Call.ReturnSeq] or [Call.ReturnFn].
 func (c *Call) Return(args ...any) *Call {
 	if c.proxy.IsValid() {
 		panic("proxy calls cannot have return values")
 	}
-	c.returns = args
+	c.returns, c.returnSeq, c.returnFn = args, nil, nil
+	return c
+}
+
+// ReturnSeq sets the values that will be returned from consecutive
+// invocations of the mocked method: the first call returns the first element,
+// the second call the second, and so on. When the method is called more times
+// than there are elements, the last one is returned. Use [Call.Times] to limit
+// the number of calls.
+//
+// It panics if called on a proxy call or without values. It replaces values
+// set with [Call.Return] or [Call.ReturnFn].
+//
+// Example:
+//
+//	mck.On("Get", "key").ReturnSeq(
+//	    mock.Arguments{"", errors.New("timeout")},
+//	    mock.Arguments{"", errors.New("timeout")},
+//	    mock.Arguments{"value", nil},
+//	).Times(3)
+func (c *Call) ReturnSeq(rets ...Arguments) *Call {
+	if c.proxy.IsValid() {
+		panic("proxy calls cannot have return values")
+	}
+	if len(rets) == 0 {
+		panic("mock.Call.ReturnSeq requires at least one set of values")
+	}
+	c.returns, c.returnSeq, c.returnFn = nil, rets, nil
+	return c
+}
+
+// ReturnFn sets the function computing the values that will be returned when
+// the mocked method is invoked. The function is called with the arguments
+// the method was called with, after the functions registered with
+// [Call.Alter].
+//
+// It panics if called on a proxy call or with a nil function. It replaces
+// values set with [Call.Return] or [Call.ReturnSeq].
+//
+// Example:
+//
+//	mck.On("Add", mock.AnyInt, mock.AnyInt).ReturnFn(
+//	    func(args mock.Arguments) mock.Arguments {
+//	        return mock.Arguments{args.Int(0) + args.Int(1)}
+//	    },
+//	)
+func (c *Call) ReturnFn(fn func(Arguments) Arguments) *Call {
+	if c.proxy.IsValid() {
+		panic("proxy calls cannot have return values")
+	}
+	if fn == nil {
+		panic("a nil function passed to mock.Call.ReturnFn")
+	}
+	c.returns, c.returnSeq, c.returnFn = nil, nil, fn
 	return c
 }
 
@@ -180,6 +256,30 @@ func (c *Call) After(d time.Duration) *Call {
 	return c
 }
 
+// WatchCtx makes the mocked method blocked by [Call.Until] or [Call.After]
+// watch the first [context.Context] argument it was called with. When the
+// context is cancelled before the blocking ends, the method returns
+// immediately with values computed by "fn", without calling the functions
+// registered with [Call.Alter] or panicking (see [Call.Panic]).
+//
+// It panics when "fn" is nil.
+//
+// Example:
+//
+//	mck.On("Fetch", mock.AnyCtx, "key").
+//	    Return("value", nil).
+//	    After(time.Hour).
+//	    WatchCtx(func(ctx context.Context) mock.Arguments {
+//	        return mock.Arguments{"", ctx.Err()}
+//	    })
+func (c *Call) WatchCtx(fn func(ctx context.Context) Arguments) *Call {
+	if fn == nil {
+		panic("a nil function passed to mock.Call.WatchCtx")
+	}
+	c.ctxFn = fn
+	return c
+}
+
 // Alter registers functions to be called with the received arguments
 // immediately before the mock returns (or after any delay). Commonly used
 // to mutate pointer arguments before the real implementation sees them.
@@ -211,10 +311,24 @@ func (c *Call) Requires(calls ...*Call) *Call {
 	return c
 }
 
+// InSequence adds the call at the end of the sequence. The call can be made
+// only in the order defined by the sequence (see [Sequence]). It panics when
+// the call already belongs to a sequence.
+func (c *Call) InSequence(seq *Sequence) *Call {
+	if c.seq != nil {
+		panic("mock.Call already belongs to a sequence")
+	}
+	c.seq = seq
+	seq.add(c)
+	return c
+}
+
 // CanCall reports whether this expectation can be satisfied by one more call
 // right now. Returns nil if allowed, otherwise one of the Err* sentinels or
 // a richer [notice.Notice] explaining the violation.
 func (c *Call) CanCall() error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
 	err := c.satisfied(c.haveCalls + 1)
 	if err == nil ||
 		errors.Is(err, ErrNeverCalled) || errors.Is(err, ErrTooFewCalls) {
@@ -226,9 +340,95 @@ func (c *Call) CanCall() error {
 // Satisfied reports whether this expectation has been fully met (correct
 // call count + all prerequisites satisfied).
 func (c *Call) Satisfied() bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
 	return c.satisfied(c.haveCalls) == nil
 }
 
+// WaitSatisfied blocks until the expectation is satisfied (see
+// [Call.Satisfied]) but no longer than the given duration. Returns nil when
+// the expectation was satisfied in time, otherwise it returns an error
+// wrapping [ErrNeverCalled] or [ErrTooFewCalls] describing the expectation.
+// When the method was called more times than expected, it returns the error
+// wrapping [ErrTooManyCalls] right away, without waiting.
+//
+// The method doesn't poll, it's woken up every time the method is called, so
+// it's suitable for waiting for calls made from other goroutines.
+//
+// Example:
+//
+//	call := mck.On("Notify", "done").Once()
+//	go svc.Run()
+//	assert.NoError(t, call.WaitSatisfied(time.Second))
+func (c *Call) WaitSatisfied(within time.Duration) error {
+	tmr := time.NewTimer(within)
+	defer tmr.Stop()
+
+	for {
+		c.mx.Lock()
+		err := c.satisfied(c.haveCalls)
+		if err == nil || errors.Is(err, ErrTooManyCalls) {
+			c.mx.Unlock()
+			return err
+		}
+		changed := c.signal()
+		c.mx.Unlock()
+
+		select {
+		case <-changed:
+		case <-tmr.C:
+			return c.waitNotice(within)
+		}
+	}
+}
+
+// waitNotice returns the error describing expectation not satisfied within
+// the given time.
+func (c *Call) waitNotice(within time.Duration) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	err := c.satisfied(c.haveCalls)
+	if err == nil || errors.Is(err, ErrTooManyCalls) {
+		return err // Called right before the timeout.
+	}
+
+	wCls := "at least 1"
+	if c.wantCalls > 0 {
+		wCls = strconv.Itoa(c.wantCalls)
+	}
+	method := formatMethod(c.Method, c.args, c.returns)
+	msg := notice.New(hWaitTimeout).Append("method", "%s", method)
+	if len(c.args) > 0 {
+		_ = msg.Append("expected args", "\n%s", formatArgs(c.args))
+	}
+	_ = msg.Append("want calls", "%s", wCls).
+		Append("have calls", "%d", c.haveCalls).
+		Append("within", "%s", within.String())
+	if c.haveCalls == 0 {
+		return msg.Wrap(ErrNeverCalled)
+	}
+	return msg.Wrap(ErrTooFewCalls)
+}
+
+// signal returns the channel closed when the number of calls changes. It
+// must be called with the mutex held.
+func (c *Call) signal() <-chan struct{} {
+	if c.changed == nil {
+		c.changed = make(chan struct{})
+	}
+	return c.changed
+}
+
+// notify wakes up goroutines waiting for the number of calls to change. It
+// must be called with the mutex held.
+func (c *Call) notify() {
+	if c.changed != nil {
+		close(c.changed)
+		c.changed = nil
+	}
+}
+
 // satisfied returns nil if the call requirements are satisfied. It takes
 // haveCalls instead of using instance field value, so it can be used to check
 // if it is ok to call it one more time see [Call.CanCall].
@@ -310,14 +510,65 @@ func (c *Call) checkReq(cs []string) (err error) {
 	return err
 }
 
+// checkSeq verifies that the call is made in the order defined by its
+// sequence and records it. The stack parameter should contain the stack trace
+// from where the method was invoked.
+func (c *Call) checkSeq(cs []string) error {
+	if c.seq == nil {
+		return nil
+	}
+	return c.seq.next(c, cs)
+}
+
+// inOrder returns nil when the call doesn't belong to a sequence or can be
+// made now without breaking the sequence order. Otherwise, it returns an error
+// wrapping [ErrOrder]. The stack parameter should contain the stack trace
+// from where the method was invoked.
+func (c *Call) inOrder(cs []string) error {
+	if c.seq == nil {
+		return nil
+	}
+	return c.seq.inOrder(c, cs)
+}
+
+// format returns a single line representation of the expected call with
+// argument values.
+func (c *Call) format() string {
+	if c.argsAny {
+		return c.Method + "(...)"
+	}
+	return formatCall(c.Method, c.args)
+}
+
 // call represents a call to the mocked method with arguments. Returns
 // configured return values.
 func (c *Call) call(args ...any) Arguments {
+	return c.run(c.count(args), args)
+}
+
+// count counts the call to the mocked method and captures the arguments.
+// Returns the ordinal number of the call starting from one.
+func (c *Call) count(args Arguments) int {
+	c.mx.Lock()
 	c.haveCalls++
-	if c.until != nil {
-		<-c.until
-	} else {
-		time.Sleep(c.after)
+	n := c.haveCalls
+	c.notify()
+	c.mx.Unlock()
+
+	for i, arg := range c.args {
+		if cpt, ok := arg.(capturer); ok && i < len(args) {
+			cpt.capture(args[i])
+		}
+	}
+	return n
+}
+
+// run blocks as configured with [Call.Until] or [Call.After], runs the
+// configured functions, and returns the return values for the n-th call (see
+// [Call.count]).
+func (c *Call) run(n int, args Arguments) Arguments {
+	if rets, done := c.wait(args); done {
+		return rets
 	}
 	if c.panic != nil {
 		panic(c.panic)
@@ -329,7 +580,56 @@ func (c *Call) call(args ...any) Arguments {
 	if c.proxy.IsValid() {
 		return c.callProxy(args...)
 	}
-	return c.returns
+	return c.rets(n, args)
+}
+
+// wait blocks for the time configured with [Call.Until] or [Call.After].
+// When [Call.WatchCtx] was used and the context argument is cancelled before
+// the blocking ends, it returns values computed by the watch function and
+// true.
+func (c *Call) wait(args Arguments) (Arguments, bool) {
+	var ctx context.Context
+	var done <-chan struct{}
+	if c.ctxFn != nil {
+		if ctx = findCtx(args); ctx != nil {
+			done = ctx.Done()
+		}
+	}
+
+	if c.until != nil {
+		select {
+		case <-c.until:
+			return nil, false
+		case <-done:
+			return c.ctxFn(ctx), true
+		}
+	}
+	if done == nil || c.after <= 0 {
+		time.Sleep(c.after)
+		return nil, false
+	}
+	tmr := time.NewTimer(c.after)
+	defer tmr.Stop()
+	select {
+	case <-tmr.C:
+		return nil, false
+	case <-done:
+		return c.ctxFn(ctx), true
+	}
+}
+
+// rets returns values to return from the n-th call of the method called with
+// given arguments.
+func (c *Call) rets(n int, args Arguments) Arguments {
+	switch {
+	case c.returnFn != nil:
+		return c.returnFn(args)
+	case len(c.returnSeq) > 0:
+		idx := min(n, len(c.returnSeq)) - 1
+		return c.returnSeq[max(idx, 0)]
+	default:
+		return c.returns
+	}
 }
 
 // callProxy calls proxy method with given arguments. Panics if the proxy
@@ -361,6 +661,7 @@ func (c *Call) satisfy() *Call {
 	} else {
 		c.haveCalls = c.wantCalls
 	}
+	c.notify()
 	return c
 }
 
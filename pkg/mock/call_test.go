@@ -4,6 +4,7 @@
 package mock
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"testing"
@@ -206,6 +207,104 @@ func Test_Call_Return(t *testing.T) {
 	})
 }
 
+func Test_Call_ReturnSeq(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero")
+
+		// --- When ---
+		have := call.ReturnSeq(Arguments{1}, Arguments{2})
+
+		// --- Then ---
+		assert.Same(t, call, have)
+		assert.Equal(t, []Arguments{{1}, {2}}, have.returnSeq)
+	})
+
+	t.Run("replaces other return values", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero").Return(1)
+
+		// --- When ---
+		have := call.ReturnSeq(Arguments{2})
+
+		// --- Then ---
+		assert.Nil(t, have.returns)
+		assert.Equal(t, []Arguments{{2}}, have.returnSeq)
+
+		have.ReturnFn(func(Arguments) Arguments { return nil })
+		assert.Nil(t, have.returnSeq)
+		have.Return(3)
+		assert.Nil(t, have.returnFn)
+		assert.Equal(t, Arguments{3}, have.returns)
+	})
+
+	t.Run("panics without values", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero")
+
+		// --- When ---
+		have := assert.PanicMsg(t, func() { call.ReturnSeq() })
+
+		// --- Then ---
+		wMsg := "mock.Call.ReturnSeq requires at least one set of values"
+		assert.Equal(t, wMsg, *have)
+	})
+
+	t.Run("panics if proxy call", func(t *testing.T) {
+		// --- Given ---
+		ptr := &testcases.TPtr{Val: "c"}
+		prx := reflect.ValueOf(ptr.AAA)
+		call := newProxy(prx)
+
+		// --- When ---
+		have := assert.PanicMsg(t, func() { call.ReturnSeq(Arguments{1}) })
+
+		// --- Then ---
+		assert.Equal(t, *have, "proxy calls cannot have return values")
+	})
+}
+
+func Test_Call_ReturnFn(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero").Return(1)
+		fn := func(Arguments) Arguments { return Arguments{2} }
+
+		// --- When ---
+		have := call.ReturnFn(fn)
+
+		// --- Then ---
+		assert.Same(t, call, have)
+		assert.Same(t, fn, have.returnFn)
+		assert.Nil(t, have.returns)
+	})
+
+	t.Run("panics with nil function", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero")
+
+		// --- When ---
+		have := assert.PanicMsg(t, func() { call.ReturnFn(nil) })
+
+		// --- Then ---
+		assert.Equal(t, "a nil function passed to mock.Call.ReturnFn", *have)
+	})
+
+	t.Run("panics if proxy call", func(t *testing.T) {
+		// --- Given ---
+		ptr := &testcases.TPtr{Val: "c"}
+		prx := reflect.ValueOf(ptr.AAA)
+		call := newProxy(prx)
+		fn := func(Arguments) Arguments { return nil }
+
+		// --- When ---
+		have := assert.PanicMsg(t, func() { call.ReturnFn(fn) })
+
+		// --- Then ---
+		assert.Equal(t, *have, "proxy calls cannot have return values")
+	})
+}
+
 func Test_Call_Panic(t *testing.T) {
 	t.Run("with string", func(t *testing.T) {
 		// --- Given ---
@@ -311,6 +410,32 @@ func Test_Call_After(t *testing.T) {
 	assert.Equal(t, 100*time.Millisecond, have.after)
 }
 
+func Test_Call_WatchCtx(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero")
+		fn := func(context.Context) Arguments { return nil }
+
+		// --- When ---
+		have := call.WatchCtx(fn)
+
+		// --- Then ---
+		assert.Same(t, call, have)
+		assert.Same(t, fn, have.ctxFn)
+	})
+
+	t.Run("panics with nil function", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero")
+
+		// --- When ---
+		have := assert.PanicMsg(t, func() { call.WatchCtx(nil) })
+
+		// --- Then ---
+		assert.Equal(t, "a nil function passed to mock.Call.WatchCtx", *have)
+	})
+}
+
 func Test_Call_Alter(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// --- Given ---
@@ -385,6 +510,35 @@ func Test_Call_Requires(t *testing.T) {
 	})
 }
 
+func Test_Call_InSequence(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		seq := NewSequence()
+		call0 := newCall("Zero")
+		call1 := newCall("One")
+
+		// --- When ---
+		have0 := call0.InSequence(seq)
+		have1 := call1.InSequence(seq)
+
+		// --- Then ---
+		assert.Same(t, call0, have0)
+		assert.Same(t, call1, have1)
+		assert.Same(t, seq, call0.seq)
+		assert.Same(t, seq, call1.seq)
+		assert.Equal(t, []*Call{call0, call1}, seq.steps)
+	})
+
+	t.Run("panics when already in a sequence", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero").InSequence(NewSequence())
+
+		// --- Then ---
+		msg := assert.PanicMsg(t, func() { call.InSequence(NewSequence()) })
+		assert.Equal(t, "mock.Call already belongs to a sequence", *msg)
+	})
+}
+
 func Test_Call_CanCall_tabular(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -455,6 +609,109 @@ func Test_Call_Satisfied(t *testing.T) {
 	})
 }
 
+func Test_Call_WaitSatisfied(t *testing.T) {
+	t.Run("already satisfied", func(t *testing.T) {
+		// --- Given ---
+		call := &Call{wantCalls: 1, haveCalls: 1}
+
+		// --- When ---
+		err := call.WaitSatisfied(time.Millisecond)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero").Optional()
+
+		// --- When ---
+		err := call.WaitSatisfied(time.Millisecond)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("satisfied by calls from other goroutine", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero").Times(2)
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			call.call()
+			call.call()
+		}()
+
+		// --- When ---
+		err := call.WaitSatisfied(time.Second)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, call.Satisfied())
+	})
+
+	t.Run("satisfied with satisfy", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero")
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			call.satisfy()
+		}()
+
+		// --- When ---
+		err := call.WaitSatisfied(time.Second)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - timeout", func(t *testing.T) {
+		// --- Given ---
+		call := &Call{
+			cStack:    cStack{Method: "Method"},
+			wantCalls: 2,
+			haveCalls: 1,
+			args:      []any{1},
+			returns:   []any{2},
+		}
+
+		// --- When ---
+		err := call.WaitSatisfied(10 * time.Millisecond)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooFewCalls, err)
+		want := goldy.Open(t, "testdata/wait_timeout.gld")
+		assert.ErrorEqual(t, want.String(), err)
+	})
+
+	t.Run("error - timeout never called", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero")
+
+		// --- When ---
+		err := call.WaitSatisfied(10 * time.Millisecond)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNeverCalled, err)
+		want := goldy.Open(t, "testdata/wait_timeout_never.gld")
+		assert.ErrorEqual(t, want.String(), err)
+	})
+
+	t.Run("error - too many calls returned without waiting", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero").Once()
+		call.call()
+		call.call()
+		start := time.Now()
+
+		// --- When ---
+		err := call.WaitSatisfied(time.Second)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooManyCalls, err)
+		assert.True(t, time.Since(start) < 500*time.Millisecond)
+	})
+}
+
 func Test_Call_satisfied_tabular(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -566,6 +823,75 @@ func Test_Call_call(t *testing.T) {
 		assert.Equal(t, 1, call.haveCalls)
 	})
 
+	t.Run("with returns", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero").Return(1)
+
+		// --- When ---
+		have := call.call()
+
+		// --- Then ---
+		assert.Equal(t, Arguments{1}, have)
+	})
+
+	t.Run("with return sequence", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero").ReturnSeq(Arguments{1}, Arguments{2})
+
+		// --- When ---
+		have0 := call.call()
+		have1 := call.call()
+		have2 := call.call()
+
+		// --- Then ---
+		assert.Equal(t, Arguments{1}, have0)
+		assert.Equal(t, Arguments{2}, have1)
+		assert.Equal(t, Arguments{2}, have2)
+		assert.Equal(t, 3, call.haveCalls)
+	})
+
+	t.Run("with return function after alter", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero", AnyInt).
+			Alter(func(args Arguments) { args[0] = args.Int(0) * 10 }).
+			ReturnFn(func(args Arguments) Arguments {
+				return Arguments{args.Int(0) + 1}
+			})
+
+		// --- When ---
+		have := call.call(1)
+
+		// --- Then ---
+		assert.Equal(t, Arguments{11}, have)
+	})
+
+	t.Run("with return sequence and panic", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero").ReturnSeq(Arguments{1}).Panic("test panic")
+
+		// --- When ---
+		have := assert.PanicMsg(t, func() { call.call() })
+
+		// --- Then ---
+		assert.Equal(t, "test panic", *have)
+		assert.Equal(t, 1, call.haveCalls)
+	})
+
+	t.Run("with return function and sleep", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero").
+			After(50 * time.Millisecond).
+			ReturnFn(func(Arguments) Arguments { return Arguments{1} })
+		now := time.Now()
+
+		// --- When ---
+		have := call.call()
+
+		// --- Then ---
+		assert.True(t, time.Since(now) > 50*time.Millisecond)
+		assert.Equal(t, Arguments{1}, have)
+	})
+
 	t.Run("with until", func(t *testing.T) {
 		// --- Given ---
 		ch := time.After(50 * time.Millisecond)
@@ -595,6 +921,118 @@ func Test_Call_call(t *testing.T) {
 		assert.Equal(t, 1, call.haveCalls)
 	})
 
+	t.Run("with until and cancelled context", func(t *testing.T) {
+		// --- Given ---
+		ctx, cancel := context.WithCancel(context.Background())
+		var altered bool
+		call := newCall("Zero", AnyCtx).
+			Return(1, nil).
+			Until(make(chan time.Time)).
+			Panic("test panic").
+			Alter(func(Arguments) { altered = true }).
+			WatchCtx(func(ctx context.Context) Arguments {
+				return Arguments{0, ctx.Err()}
+			})
+		go func() { time.Sleep(50 * time.Millisecond); cancel() }()
+
+		// --- When ---
+		have := call.call(ctx)
+
+		// --- Then ---
+		assert.Equal(t, Arguments{0, context.Canceled}, have)
+		assert.False(t, altered)
+		assert.Equal(t, 1, call.haveCalls)
+	})
+
+	t.Run("with sleep and cancelled context", func(t *testing.T) {
+		// --- Given ---
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		call := newCall("Zero", AnyCtx).
+			Return(1, nil).
+			After(time.Hour).
+			WatchCtx(func(ctx context.Context) Arguments {
+				return Arguments{0, ctx.Err()}
+			})
+
+		// --- When ---
+		have := call.call(ctx)
+
+		// --- Then ---
+		assert.Equal(t, Arguments{0, context.Canceled}, have)
+	})
+
+	t.Run("with sleep and context deadline", func(t *testing.T) {
+		// --- Given ---
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		call := newCall("Zero", "a", AnyCtx).
+			Return(1, nil).
+			After(time.Hour).
+			WatchCtx(func(ctx context.Context) Arguments {
+				return Arguments{0, ctx.Err()}
+			})
+
+		// --- When ---
+		have := call.call("a", ctx)
+
+		// --- Then ---
+		assert.Equal(t, Arguments{0, context.DeadlineExceeded}, have)
+	})
+
+	t.Run("with sleep and not cancelled context", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero", AnyCtx).
+			Return(1, nil).
+			After(50 * time.Millisecond).
+			WatchCtx(func(ctx context.Context) Arguments {
+				return Arguments{0, ctx.Err()}
+			})
+		now := time.Now()
+
+		// --- When ---
+		have := call.call(context.Background())
+
+		// --- Then ---
+		assert.True(t, time.Since(now) > 50*time.Millisecond)
+		assert.Equal(t, Arguments{1, nil}, have)
+	})
+
+	t.Run("not blocking with cancelled context", func(t *testing.T) {
+		// --- Given ---
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		call := newCall("Zero", AnyCtx).
+			Return(1, nil).
+			WatchCtx(func(ctx context.Context) Arguments {
+				return Arguments{0, ctx.Err()}
+			})
+
+		// --- When ---
+		have := call.call(ctx)
+
+		// --- Then ---
+		assert.Equal(t, Arguments{1, nil}, have)
+	})
+
+	t.Run("watching context without context argument", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero").
+			Return(1).
+			After(50 * time.Millisecond).
+			WatchCtx(func(ctx context.Context) Arguments {
+				return Arguments{0}
+			})
+		now := time.Now()
+
+		// --- When ---
+		have := call.call()
+
+		// --- Then ---
+		assert.True(t, time.Since(now) > 50*time.Millisecond)
+		assert.Equal(t, Arguments{1}, have)
+	})
+
 	t.Run("with panic", func(t *testing.T) {
 		// --- Given ---
 		call := newCall("Zero").Panic("test panic")
@@ -861,6 +1299,113 @@ func Test_Call_checkReq(t *testing.T) {
 	})
 }
 
+func Test_Call_checkSeq(t *testing.T) {
+	t.Run("not in sequence", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero")
+
+		// --- When ---
+		err := call.checkSeq(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("in order", func(t *testing.T) {
+		// --- Given ---
+		call0 := newCall("Zero")
+		call1 := newCall("One")
+		seq := InOrder(call0, call1)
+		call0.haveCalls = 1
+
+		// --- When ---
+		err := call1.checkSeq(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []*Call{call1}, seq.have)
+	})
+
+	t.Run("error - out of order", func(t *testing.T) {
+		// --- Given ---
+		call0 := newCall("Zero")
+		call1 := newCall("One")
+		InOrder(call0, call1)
+
+		// --- When ---
+		err := call1.checkSeq(nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOrder, err)
+	})
+}
+
+func Test_Call_inOrder(t *testing.T) {
+	t.Run("not in sequence", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero")
+
+		// --- When ---
+		err := call.inOrder(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("in order", func(t *testing.T) {
+		// --- Given ---
+		call0 := newCall("Zero")
+		call1 := newCall("One")
+		seq := InOrder(call0, call1)
+		call0.haveCalls = 1
+
+		// --- When ---
+		err := call1.inOrder(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, seq.have)
+	})
+
+	t.Run("error - out of order", func(t *testing.T) {
+		// --- Given ---
+		call0 := newCall("Zero")
+		call1 := newCall("One")
+		InOrder(call0, call1)
+
+		// --- When ---
+		err := call1.inOrder(nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOrder, err)
+	})
+}
+
+func Test_Call_format(t *testing.T) {
+	t.Run("with arguments", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Method", 1, "abc")
+
+		// --- When ---
+		have := call.format()
+
+		// --- Then ---
+		assert.Equal(t, `Method(1, "abc")`, have)
+	})
+
+	t.Run("any arguments", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Method")
+		call.argsAny = true
+
+		// --- When ---
+		have := call.format()
+
+		// --- Then ---
+		assert.Equal(t, "Method(...)", have)
+	})
+}
+
 func Test_Call_satisfy(t *testing.T) {
 	t.Run("number of times not defined", func(t *testing.T) {
 		// --- Given ---
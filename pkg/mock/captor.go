@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// capturer represents an argument matcher capturing the matched arguments.
+type capturer interface {
+	// matcher returns the matcher used to match the argument.
+	matcher() *Matcher
+
+	// capture stores the argument the mocked method was called with.
+	capture(have any)
+}
+
+// Captor is an argument matcher matching any value of type T and capturing
+// the arguments the mocked method was called with.
+//
+// Use [Capture] to create one. Only arguments of the calls actually made are
+// captured - argument matching done while looking for the expected call
+// does not capture values.
+type Captor[T any] struct {
+	mch    *Matcher   // Matcher for values of type T.
+	values []T        // Captured values.
+	mx     sync.Mutex // Guards the fields.
+}
+
+// Capture returns a new [Captor] which can be used as an argument matcher in
+// [Mock.On] and matches any value of type T.
+//
+// Example:
+//
+//	req := mock.Capture[*http.Request]()
+//	mck.On("Do", req).Return(rsp, nil)
+//
+//	// Code under test calls Do.
+//
+//	assert.Equal(t, "localhost", req.Last().Host)
+func Capture[T any]() *Captor[T] {
+	fn := func(T) bool { return true }
+	desc := fmt.Sprintf("[mock.Capture=%s]", reflect.TypeFor[T]().String())
+	return &Captor[T]{mch: NewMatcher(fn, desc)}
+}
+
+// Last returns the last captured value. It panics with a [notice.Notice]
+// when no value has been captured.
+func (cpt *Captor[T]) Last() T {
+	cpt.mx.Lock()
+	defer cpt.mx.Unlock()
+	if len(cpt.values) == 0 {
+		panic(notice.New("[mock] captor: Last() no values captured"))
+	}
+	return cpt.values[len(cpt.values)-1]
+}
+
+// All returns all captured values in the order the mocked method was called.
+// Returns nil when no value has been captured.
+func (cpt *Captor[T]) All() []T {
+	cpt.mx.Lock()
+	defer cpt.mx.Unlock()
+	return slices.Clone(cpt.values)
+}
+
+func (cpt *Captor[T]) matcher() *Matcher { return cpt.mch }
+
+func (cpt *Captor[T]) capture(have any) {
+	cpt.mx.Lock()
+	defer cpt.mx.Unlock()
+	val, _ := have.(T)
+	cpt.values = append(cpt.values, val)
+}
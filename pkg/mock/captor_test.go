@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_Capture(t *testing.T) {
+	t.Run("concrete type", func(t *testing.T) {
+		// --- When ---
+		have := Capture[int]()
+
+		// --- Then ---
+		assert.Equal(t, "[mock.Capture=int]", have.mch.Desc())
+		assert.True(t, have.mch.Match(1))
+		assert.False(t, have.mch.Match("a"))
+		assert.Nil(t, have.values)
+	})
+
+	t.Run("interface type", func(t *testing.T) {
+		// --- When ---
+		have := Capture[error]()
+
+		// --- Then ---
+		assert.Equal(t, "[mock.Capture=error]", have.mch.Desc())
+		assert.True(t, have.mch.Match(errors.New("e")))
+		assert.True(t, have.mch.Match(nil))
+		assert.False(t, have.mch.Match(1))
+	})
+}
+
+func Test_Captor_Last(t *testing.T) {
+	t.Run("captured", func(t *testing.T) {
+		// --- Given ---
+		cpt := Capture[int]()
+		cpt.capture(1)
+		cpt.capture(2)
+
+		// --- When ---
+		have := cpt.Last()
+
+		// --- Then ---
+		assert.Equal(t, 2, have)
+	})
+
+	t.Run("panics when nothing captured", func(t *testing.T) {
+		// --- Given ---
+		cpt := Capture[int]()
+
+		// --- Then ---
+		wMsg := "[mock] captor: Last() no values captured"
+		assert.PanicMsg(t, func() { cpt.Last() }, wMsg)
+	})
+}
+
+func Test_Captor_All(t *testing.T) {
+	t.Run("captured", func(t *testing.T) {
+		// --- Given ---
+		cpt := Capture[int]()
+		cpt.capture(1)
+		cpt.capture(2)
+
+		// --- When ---
+		have := cpt.All()
+
+		// --- Then ---
+		assert.Equal(t, []int{1, 2}, have)
+		have[0] = 3
+		assert.Equal(t, []int{1, 2}, cpt.All())
+	})
+
+	t.Run("nothing captured", func(t *testing.T) {
+		// --- Given ---
+		cpt := Capture[int]()
+
+		// --- When ---
+		have := cpt.All()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Captor_capture(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		// --- Given ---
+		cpt := Capture[int]()
+
+		// --- When ---
+		cpt.capture(1)
+
+		// --- Then ---
+		assert.Equal(t, []int{1}, cpt.values)
+	})
+
+	t.Run("nil interface", func(t *testing.T) {
+		// --- Given ---
+		cpt := Capture[error]()
+
+		// --- When ---
+		cpt.capture(nil)
+
+		// --- Then ---
+		assert.Equal(t, []error{nil}, cpt.values)
+	})
+}
+
+func Test_Captor_Mock_integration(t *testing.T) {
+	t.Run("captures arguments of made calls", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		cpt := Capture[string]()
+		mck := NewMock(tspy)
+		mck.On("Method", 1, cpt).Return(true)
+		mck.On("Method", 2, Any).Return(false)
+
+		// --- When ---
+		mck.Call("Method", 1, "a")
+		mck.Call("Method", 2, "b")
+		mck.Call("Method", 1, "c")
+
+		// --- Then ---
+		assert.Equal(t, "c", cpt.Last())
+		assert.Equal(t, []string{"a", "c"}, cpt.All())
+	})
+
+	t.Run("captures before altering", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		cpt := Capture[int]()
+		mck := NewMock(tspy)
+		mck.On("Method", cpt).Alter(func(args Arguments) { args[0] = 2 })
+
+		// --- When ---
+		mck.Call("Method", 1)
+
+		// --- Then ---
+		assert.Equal(t, 1, cpt.Last())
+	})
+
+	t.Run("does not capture when not matched", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectFail()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		cpt := Capture[int]()
+		mck := NewMock(tspy)
+		mck.On("Method", cpt, "a")
+
+		// --- When ---
+		assert.Panic(t, func() { mck.Call("Method", 1, "b") })
+
+		// --- Then ---
+		assert.Nil(t, cpt.All())
+	})
+
+	t.Run("concurrent calls", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		cpt := Capture[int]()
+		mck := NewMock(tspy)
+		mck.On("Method", cpt)
+
+		// --- When ---
+		var wg sync.WaitGroup
+		for i := range 10 {
+			wg.Go(func() {
+				mck.Call("Method", i)
+				_ = cpt.All()
+			})
+		}
+		wg.Wait()
+
+		// --- Then ---
+		assert.Len(t, 10, cpt.All())
+	})
+}
@@ -4,10 +4,13 @@
 package mock
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -76,6 +79,27 @@ func callStack() []string {
 	return callers
 }
 
+// findCtx returns the first non-nil [context.Context] argument or nil when
+// there is none.
+func findCtx(args Arguments) context.Context {
+	for _, arg := range args {
+		if ctx, ok := arg.(context.Context); ok && ctx != nil {
+			return ctx
+		}
+	}
+	return nil
+}
+
+// goroutineID returns the ID of the current goroutine.
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	id, _, _ := bytes.Cut(buf, []byte(" "))
+	n, _ := strconv.ParseUint(string(id), 10, 64)
+	return n
+}
+
 // formatMethod returns formatted string representing the method and its input
 // and return arguments.
 func formatMethod(method string, args, rets Arguments) string {
@@ -92,6 +116,32 @@ func formatMethod(method string, args, rets Arguments) string {
 	return strings.Join(out, "\n")
 }
 
+// formatCall returns a single line representation of the method call with
+// argument values.
+func formatCall(method string, args Arguments) string {
+	return method + "(" + strings.Join(flatArgs(args), ", ") + ")"
+}
+
+// flatArgs returns single line representations of the argument values.
+func flatArgs(args Arguments) []string {
+	strs := make([]string, 0, len(args))
+	for _, arg := range args {
+		if cpt, ok := arg.(capturer); ok {
+			arg = cpt.matcher()
+		}
+		if mch, ok := arg.(*Matcher); ok {
+			strs = append(strs, mch.Desc())
+			continue
+		}
+		if arg == Any {
+			strs = append(strs, Any)
+			continue
+		}
+		strs = append(strs, flatDumper.Any(arg))
+	}
+	return strs
+}
+
 // formatArgs returns formatted multi-line string representing arguments. Uses
 // internal [dump.Dump].
 func formatArgs(args Arguments) string {
@@ -100,6 +150,9 @@ func formatArgs(args Arguments) string {
 	}
 	var out []string
 	for idx, arg := range args {
+		if cpt, ok := arg.(capturer); ok {
+			arg = cpt.matcher()
+		}
 		out = append(out, fmt.Sprintf("%d: %s", idx, dumper.Any(arg)))
 	}
 	return strings.Join(out, "\n")
@@ -143,24 +196,3 @@ func methodName(met reflect.Value) string {
 	last = strings.TrimSuffix(last, "-fm")  // Go 1.5
 	return last
 }
-
-// twoColumns aligns the text in the second column to the longest string in the
-// first column. Stores the result in the first column.
-//
-// Example:
-//
-//	aaa 111
-//	bb  222
-//	c   333
-func twoColumns(col1, col2 []string) {
-	var longest int
-	for _, s := range col1 {
-		if len(s) > longest {
-			longest = len(s)
-		}
-	}
-	for i, s := range col2 {
-		ind := strings.Repeat(" ", longest-len(col1[i]))
-		col1[i] = fmt.Sprintf("%s %s-> %s", col1[i], ind, s)
-	}
-}
@@ -4,6 +4,7 @@
 package mock
 
 import (
+	"context"
 	"reflect"
 	"strconv"
 	"strings"
@@ -26,6 +27,42 @@ func Test_callStack(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_findCtx(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		// --- Given ---
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// --- When ---
+		have := findCtx(Arguments{1, nil, ctx, context.TODO()})
+
+		// --- Then ---
+		assert.Same(t, ctx, have)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// --- When ---
+		have := findCtx(Arguments{1, nil})
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_goroutineID(t *testing.T) {
+	// --- Given ---
+	ch := make(chan uint64)
+
+	// --- When ---
+	have := goroutineID()
+	go func() { ch <- goroutineID() }()
+
+	// --- Then ---
+	assert.NotZero(t, have)
+	assert.NotEqual(t, have, <-ch)
+	assert.Equal(t, have, goroutineID())
+}
+
 func Test_formatMethod_tabular(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -78,6 +115,39 @@ func Test_formatMethod_tabular(t *testing.T) {
 	}
 }
 
+func Test_formatCall_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		method string
+		args   Arguments
+		want   string
+	}{
+		{"no args", "Method", nil, "Method()"},
+		{"one arg", "Method", []any{1}, "Method(1)"},
+		{"many args", "Method", []any{1, "abc", nil}, `Method(1, "abc", nil)`},
+		{"any", "Method", []any{Any}, "Method(mock.Any)"},
+		{"matcher", "Method", []any{AnyInt}, "Method([mock.MatchOfType=int])"},
+		{"captor", "Method", []any{Capture[int]()}, "Method([mock.Capture=int])"},
+		{
+			"struct",
+			"Method",
+			[]any{struct{ A int }{A: 1}},
+			"Method({A:1})",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := formatCall(tc.method, tc.args)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
 func Test_formatArgs_tabular(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -148,34 +218,3 @@ func Test_methodName(t *testing.T) {
 	// --- Then ---
 	assert.Equal(t, "AAA", have)
 }
-
-func Test_twoColumns_tabular(t *testing.T) {
-	tt := []struct {
-		testN string
-
-		col1 []string
-		col2 []string
-		want []string
-	}{
-		{
-			"1",
-			[]string{"aaa", "bb", "c"},
-			[]string{"111", "222", "333"},
-			[]string{
-				"aaa -> 111",
-				"bb  -> 222",
-				"c   -> 333",
-			},
-		},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.testN, func(t *testing.T) {
-			// --- When ---
-			twoColumns(tc.col1, tc.col2)
-
-			// --- Then ---
-			assert.Equal(t, tc.want, tc.col1)
-		})
-	}
-}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"time"
+)
+
+// Invocation represents a recorded call of a mocked method.
+//
+// Invocations are returned by [Mock.Calls] in the order the calls were made.
+type Invocation struct {
+	// The name of the called method.
+	Method string
+
+	// Arguments the method was called with.
+	Args Arguments
+
+	// Values returned from the method. It is nil when the method panicked.
+	Returns Arguments
+
+	// Time the method was called.
+	Time time.Time
+
+	// ID of the goroutine the method was called from.
+	Goroutine uint64
+
+	// The call stack of the method call. Empty when the mock was created with
+	// the [WithNoStack] option.
+	Stack []string
+
+	// Set to true when the call didn't match any expectation and was allowed
+	// only because the mock is nice (see [WithNice]).
+	Unexpected bool
+}
@@ -15,6 +15,10 @@ import (
 type Matcher struct {
 	fn   reflect.Value // Matcher function.
 	desc string        // Matcher description.
+
+	// Optional function explaining why the argument does not match. Each
+	// returned line is shown in [Arguments.Diff] output.
+	why func(have any) []string
 }
 
 // NewMatcher creates a Matcher from a predicate function and a human-readable
@@ -7,10 +7,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/ctx42/testing/internal/core"
+	"github.com/ctx42/testing/pkg/check"
 )
 
 // AnyString matches any argument whose dynamic type is string.
@@ -118,6 +123,407 @@ func MatchError(want any) *Matcher {
 	return mby
 }
 
+// MatchAll returns a matcher that accepts arguments matched by all the given
+// matchers. It panics when no matchers are given.
+//
+// Example:
+//
+//	MatchAll(AnyString, MatchLen(3))
+func MatchAll(ms ...*Matcher) *Matcher {
+	if len(ms) == 0 {
+		panic("mock: MatchAll: no matchers")
+	}
+	fn := func(have any) bool {
+		for _, m := range ms {
+			if !m.Match(have) {
+				return false
+			}
+		}
+		return true
+	}
+	desc := fmt.Sprintf("[mock.MatchAll=%s]", matchersDesc(ms, " && "))
+	return NewMatcher(fn, desc)
+}
+
+// MatchAny returns a matcher that accepts arguments matched by at least one
+// of the given matchers. It panics when no matchers are given.
+//
+// Example:
+//
+//	MatchAny(MatchError(io.EOF), MatchError(io.ErrUnexpectedEOF))
+func MatchAny(ms ...*Matcher) *Matcher {
+	if len(ms) == 0 {
+		panic("mock: MatchAny: no matchers")
+	}
+	fn := func(have any) bool {
+		for _, m := range ms {
+			if m.Match(have) {
+				return true
+			}
+		}
+		return false
+	}
+	desc := fmt.Sprintf("[mock.MatchAny=%s]", matchersDesc(ms, " || "))
+	return NewMatcher(fn, desc)
+}
+
+// MatchNot returns a matcher that accepts arguments not matched by the given
+// matcher.
+//
+// Example:
+//
+//	MatchNot(MatchSame(ptr))
+func MatchNot(m *Matcher) *Matcher {
+	fn := func(have any) bool { return !m.Match(have) }
+	desc := fmt.Sprintf("[mock.MatchNot=%s]", m.Desc())
+	return NewMatcher(fn, desc)
+}
+
+// MatchRegexp returns a matcher that accepts strings, byte slices and values
+// implementing [fmt.Stringer] matching the regular expression. It panics
+// when the regular expression cannot be compiled.
+func MatchRegexp(pattern string) *Matcher {
+	rx := regexp.MustCompile(pattern)
+	fn := func(have any) bool {
+		switch val := have.(type) {
+		case string:
+			return rx.MatchString(val)
+		case []byte:
+			return rx.Match(val)
+		case fmt.Stringer:
+			return rx.MatchString(val.String())
+		default:
+			return false
+		}
+	}
+	desc := fmt.Sprintf("[mock.MatchRegexp=%s]", pattern)
+	return NewMatcher(fn, desc)
+}
+
+// MatchContains returns a matcher that accepts:
+//
+//   - strings containing the substring want,
+//   - slices and arrays with an element matching want,
+//   - maps with a key matching want.
+//
+// The want value may be a [Matcher] or [Any]; other values are compared
+// using [check.Equal].
+func MatchContains(want any) *Matcher {
+	fn := func(have any) bool {
+		if str, ok := have.(string); ok {
+			sub, ok := want.(string)
+			return ok && strings.Contains(str, sub)
+		}
+		val := reflect.ValueOf(have)
+		switch val.Kind() { // nolint: exhaustive
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < val.Len(); i++ {
+				if matchValue(want, val.Index(i).Interface()) {
+					return true
+				}
+			}
+		case reflect.Map:
+			for _, key := range val.MapKeys() {
+				if matchValue(want, key.Interface()) {
+					return true
+				}
+			}
+		default:
+		}
+		return false
+	}
+	desc := fmt.Sprintf("[mock.MatchContains=%s]", valueDesc(want))
+	return NewMatcher(fn, desc)
+}
+
+// MatchLen returns a matcher that accepts strings, slices, arrays, maps and
+// channels of the given length.
+func MatchLen(want int) *Matcher {
+	fn := func(have any) bool {
+		val := reflect.ValueOf(have)
+		switch val.Kind() { // nolint: exhaustive
+		case reflect.String, reflect.Slice, reflect.Array:
+			return val.Len() == want
+		case reflect.Map, reflect.Chan:
+			return val.Len() == want
+		default:
+			return false
+		}
+	}
+	desc := fmt.Sprintf("[mock.MatchLen=%d]", want)
+	return NewMatcher(fn, desc)
+}
+
+// MatchElements returns a matcher that accepts slices and arrays with the
+// same number of elements as want and each element matching the "want"
+// element at the same index. The "want" elements may be matchers or [Any];
+// other values are compared using [check.Equal].
+//
+// Example:
+//
+//	MatchElements(AnyString, "b", MatchRegexp("^c"))
+func MatchElements(want ...any) *Matcher {
+	fn := func(have any) bool {
+		val := reflect.ValueOf(have)
+		if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+			return false
+		}
+		if val.Len() != len(want) {
+			return false
+		}
+		for i, w := range want {
+			if !matchValue(w, val.Index(i).Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+	desc := fmt.Sprintf("[mock.MatchElements=%s]", valuesDesc(want))
+	return NewMatcher(fn, desc)
+}
+
+// MatchKeys returns a matcher that accepts maps having all the given keys.
+// The keys may be matchers or [Any]; other values are compared using
+// [check.Equal].
+//
+// Example:
+//
+//	MatchKeys("id", "name")
+func MatchKeys(want ...any) *Matcher {
+	fn := func(have any) bool {
+		val := reflect.ValueOf(have)
+		if val.Kind() != reflect.Map {
+			return false
+		}
+		keys := val.MapKeys()
+		for _, w := range want {
+			found := false
+			for _, key := range keys {
+				if matchValue(w, key.Interface()) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	desc := fmt.Sprintf("[mock.MatchKeys=%s]", valuesDesc(want))
+	return NewMatcher(fn, desc)
+}
+
+// MatchEqual returns a matcher that accepts arguments equal to want using
+// [check.Equal] with the given options.
+//
+// Example:
+//
+//	MatchEqual(want, check.WithSkipTrail("User.Created"))
+func MatchEqual(want any, opts ...any) *Matcher {
+	fn := func(have any) bool { return check.Equal(want, have, opts...) == nil }
+	desc := fmt.Sprintf("[mock.MatchEqual=%s]", valueDesc(want))
+	return NewMatcher(fn, desc)
+}
+
+// MatchFields returns a matcher that accepts structs (or pointers to structs)
+// with the fields at the given paths matching the values. The paths use the
+// same syntax as [check.Equal] trails without the leading type name, e.g.,
+// "User.ID", "Items[0].Name" or `Meta["key"]`. Pointers and interfaces on
+// the path are dereferenced. The values may be matchers or [Any]; other
+// values are compared using [check.Equal].
+//
+// When the argument doesn't match, the [Arguments.Diff] output lists the
+// paths which failed to match.
+//
+// Example:
+//
+//	MatchFields(map[string]any{"User.ID": 7, "Limit": mock.Any})
+func MatchFields(fields map[string]any) *Matcher {
+	paths := slices.Sorted(maps.Keys(fields))
+
+	why := func(have any) []string {
+		var lines []string
+		for _, pth := range paths {
+			want := fields[pth]
+			wantFmt := valueDesc(want)
+			if _, ok := want.(*Matcher); !ok && want != Any {
+				wantFmt = fmt.Sprintf("(%[1]T=%#[1]v)", want)
+			}
+			haveFmt := "(Missing)"
+			val, ok := fieldValue(reflect.ValueOf(have), pth)
+			if ok {
+				if matchValue(want, val.Interface()) {
+					continue
+				}
+				haveFmt = fmt.Sprintf("(%[1]T=%#[1]v)", val.Interface())
+			}
+			line := fmt.Sprintf("FAIL: %s: %s != %s", pth, wantFmt, haveFmt)
+			lines = append(lines, line)
+		}
+		return lines
+	}
+
+	descs := make([]string, 0, len(paths))
+	for _, pth := range paths {
+		descs = append(descs, pth+": "+valueDesc(fields[pth]))
+	}
+	fn := func(have any) bool { return len(why(have)) == 0 }
+	desc := fmt.Sprintf("[mock.MatchFields=%s]", strings.Join(descs, ", "))
+	mch := NewMatcher(fn, desc)
+	mch.why = why
+	return mch
+}
+
+// fieldValue returns the value at the path in the struct. The path uses the
+// [check.Equal] trail syntax without the leading type name. Returns false
+// when the path doesn't exist.
+//
+// nolint: cyclop
+func fieldValue(val reflect.Value, pth string) (reflect.Value, bool) {
+	for pth != "" {
+		for val.Kind() == reflect.Pointer || val.Kind() == reflect.Interface {
+			if val.IsNil() {
+				return reflect.Value{}, false
+			}
+			val = val.Elem()
+		}
+
+		if !strings.HasPrefix(pth, "[") {
+			pth = strings.TrimPrefix(pth, ".")
+			end := strings.IndexAny(pth, ".[")
+			if end < 0 {
+				end = len(pth)
+			}
+			if val.Kind() != reflect.Struct {
+				return reflect.Value{}, false
+			}
+			val = val.FieldByName(pth[:end])
+			if !val.IsValid() || !val.CanInterface() {
+				return reflect.Value{}, false
+			}
+			pth = pth[end:]
+			continue
+		}
+
+		var key string
+		var quoted bool
+		if strings.HasPrefix(pth, `["`) {
+			str, err := strconv.QuotedPrefix(pth[1:])
+			if err != nil || !strings.HasPrefix(pth[1+len(str):], "]") {
+				return reflect.Value{}, false
+			}
+			key, _ = strconv.Unquote(str)
+			quoted = true
+			pth = pth[len(str)+2:]
+		} else {
+			end := strings.Index(pth, "]")
+			if end < 0 {
+				return reflect.Value{}, false
+			}
+			key = pth[1:end]
+			pth = pth[end+1:]
+		}
+
+		switch val.Kind() { // nolint: exhaustive
+		case reflect.Slice, reflect.Array:
+			idx, err := strconv.Atoi(key)
+			if quoted || err != nil || idx < 0 || idx >= val.Len() {
+				return reflect.Value{}, false
+			}
+			val = val.Index(idx)
+
+		case reflect.Map:
+			kVal, ok := mapKey(val.Type().Key(), key, quoted)
+			if !ok {
+				return reflect.Value{}, false
+			}
+			val = val.MapIndex(kVal)
+			if !val.IsValid() {
+				return reflect.Value{}, false
+			}
+
+		default:
+			return reflect.Value{}, false
+		}
+	}
+	return val, true
+}
+
+// mapKey returns the map key of the given type represented by the string.
+// The quoted keys are used for maps with string keys, the not quoted keys for
+// maps with integer keys.
+func mapKey(typ reflect.Type, key string, quoted bool) (reflect.Value, bool) {
+	if quoted {
+		if typ.Kind() != reflect.String {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(key).Convert(typ), true
+	}
+
+	switch typ.Kind() { // nolint: exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		num, err := strconv.ParseInt(key, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(num).Convert(typ), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		num, err := strconv.ParseUint(key, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(num).Convert(typ), true
+
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// matchValue returns true when have is matched by want. The want may be a
+// [Matcher], [Any] or a value compared using [check.Equal].
+func matchValue(want, have any) bool {
+	if w, ok := want.(*Matcher); ok {
+		return w.Match(have)
+	}
+	if want == Any {
+		return true
+	}
+	return check.Equal(want, have) == nil
+}
+
+// matchersDesc returns descriptions of the matchers joined with sep.
+func matchersDesc(ms []*Matcher, sep string) string {
+	descs := make([]string, 0, len(ms))
+	for _, m := range ms {
+		descs = append(descs, m.Desc())
+	}
+	return strings.Join(descs, sep)
+}
+
+// valueDesc returns the description of the value used by matchers.
+func valueDesc(val any) string {
+	if m, ok := val.(*Matcher); ok {
+		return m.Desc()
+	}
+	if val == Any {
+		return "mock.Any"
+	}
+	return fmt.Sprintf("%#v", val)
+}
+
+// valuesDesc returns comma separated descriptions of the values used by
+// matchers.
+func valuesDesc(vals []any) string {
+	descs := make([]string, 0, len(vals))
+	for _, val := range vals {
+		descs = append(descs, valueDesc(val))
+	}
+	return strings.Join(descs, ", ")
+}
+
 // AnySlice returns a slice of length cnt filled with [Any] sentinels.
 // Useful when building expectations for variadic methods or slices.
 func AnySlice(cnt int) []any {
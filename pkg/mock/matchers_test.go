@@ -4,12 +4,15 @@
 package mock
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/check"
 	"github.com/ctx42/testing/pkg/testcases"
 )
 
@@ -377,3 +380,515 @@ func Test_AnySlice(t *testing.T) {
 	assert.Equal(t, Any, have[1])
 	assert.Equal(t, Any, have[2])
 }
+
+func Test_MatchAll(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchAll(AnyString, MatchLen(3))
+
+		// --- When ---
+		have := mch.Match("abc")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not match", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchAll(AnyString, MatchLen(3))
+
+		// --- When ---
+		have := mch.Match("abcd")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("description", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchAll(AnyString, MatchLen(3))
+
+		// --- When ---
+		have := mch.Desc()
+
+		// --- Then ---
+		want := "[mock.MatchAll=[mock.MatchOfType=string] && [mock.MatchLen=3]]"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("panics when no matchers", func(t *testing.T) {
+		// --- When ---
+		msg := assert.PanicMsg(t, func() { MatchAll() })
+
+		// --- Then ---
+		assert.Equal(t, "mock: MatchAll: no matchers", *msg)
+	})
+}
+
+func Test_MatchAny(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchAny(AnyString, AnyInt)
+
+		// --- When ---
+		have := mch.Match(42)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not match", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchAny(AnyString, AnyInt)
+
+		// --- When ---
+		have := mch.Match(true)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("description", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchAny(AnyString, AnyInt)
+
+		// --- When ---
+		have := mch.Desc()
+
+		// --- Then ---
+		want := "[mock.MatchAny=[mock.MatchOfType=string] || " +
+			"[mock.MatchOfType=int]]"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("panics when no matchers", func(t *testing.T) {
+		// --- When ---
+		msg := assert.PanicMsg(t, func() { MatchAny() })
+
+		// --- Then ---
+		assert.Equal(t, "mock: MatchAny: no matchers", *msg)
+	})
+}
+
+func Test_MatchNot(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchNot(AnyString)
+
+		// --- When ---
+		have := mch.Match(42)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not match", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchNot(AnyString)
+
+		// --- When ---
+		have := mch.Match("abc")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("description", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchNot(AnyString)
+
+		// --- When ---
+		have := mch.Desc()
+
+		// --- Then ---
+		assert.Equal(t, "[mock.MatchNot=[mock.MatchOfType=string]]", have)
+	})
+}
+
+func Test_MatchRegexp_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		have any
+		want bool
+	}{
+		{"string match", "abc", true},
+		{"string not match", "xyz", false},
+		{"bytes match", []byte("abc"), true},
+		{"bytes not match", []byte("xyz"), false},
+		{"stringer match", bytes.NewBufferString("abc"), true},
+		{"stringer not match", bytes.NewBufferString("xyz"), false},
+		{"not supported type", 42, false},
+		{"nil", nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := MatchRegexp("^a.c$").Match(tc.have)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_MatchRegexp(t *testing.T) {
+	t.Run("description", func(t *testing.T) {
+		// --- When ---
+		have := MatchRegexp("^a.c$").Desc()
+
+		// --- Then ---
+		assert.Equal(t, "[mock.MatchRegexp=^a.c$]", have)
+	})
+
+	t.Run("panics when invalid regexp", func(t *testing.T) {
+		// --- When ---
+		msg := assert.PanicMsg(t, func() { MatchRegexp("[") })
+
+		// --- Then ---
+		assert.Contain(t, "missing closing ]", *msg)
+	})
+}
+
+func Test_MatchContains_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		want any
+		have any
+		exp  bool
+	}{
+		{"string", "bc", "abcd", true},
+		{"string not contains", "x", "abcd", false},
+		{"string with not string", 1, "abcd", false},
+		{"slice", 2, []int{1, 2, 3}, true},
+		{"slice not contains", 4, []int{1, 2, 3}, false},
+		{"slice with matcher", AnyString, []any{1, "a"}, true},
+		{"slice with any", Any, []int{1}, true},
+		{"empty slice with any", Any, []int{}, false},
+		{"array", 2, [3]int{1, 2, 3}, true},
+		{"map key", "a", map[string]int{"a": 1}, true},
+		{"map key not exist", "b", map[string]int{"a": 1}, false},
+		{"not supported type", 1, 1, false},
+		{"nil", 1, nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := MatchContains(tc.want).Match(tc.have)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_MatchContains(t *testing.T) {
+	t.Run("description", func(t *testing.T) {
+		// --- When ---
+		have := MatchContains("abc").Desc()
+
+		// --- Then ---
+		assert.Equal(t, `[mock.MatchContains="abc"]`, have)
+	})
+
+	t.Run("description with matcher", func(t *testing.T) {
+		// --- When ---
+		have := MatchContains(AnyInt).Desc()
+
+		// --- Then ---
+		assert.Equal(t, "[mock.MatchContains=[mock.MatchOfType=int]]", have)
+	})
+}
+
+func Test_MatchLen_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		have any
+		want bool
+	}{
+		{"string", "abc", true},
+		{"slice", []int{1, 2, 3}, true},
+		{"array", [3]int{1, 2, 3}, true},
+		{"map", map[int]int{1: 1, 2: 2, 3: 3}, true},
+		{"chan", func() chan int {
+			ch := make(chan int, 3)
+			ch <- 1
+			ch <- 2
+			ch <- 3
+			return ch
+		}(), true},
+		{"different length", []int{1}, false},
+		{"not supported type", 3, false},
+		{"nil", nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := MatchLen(3).Match(tc.have)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_MatchLen(t *testing.T) {
+	// --- When ---
+	have := MatchLen(3).Desc()
+
+	// --- Then ---
+	assert.Equal(t, "[mock.MatchLen=3]", have)
+}
+
+func Test_MatchElements_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		have any
+		want bool
+	}{
+		{"slice", []any{"a", "b", 1}, true},
+		{"array", [3]any{"x", "b", 2}, true},
+		{"matcher not matching", []any{1, "b", 1}, false},
+		{"value not matching", []any{"a", "c", 1}, false},
+		{"different length", []any{"a", "b"}, false},
+		{"not supported type", "abc", false},
+		{"nil", nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			mch := MatchElements(AnyString, "b", Any)
+
+			// --- When ---
+			have := mch.Match(tc.have)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_MatchElements(t *testing.T) {
+	// --- When ---
+	have := MatchElements(AnyString, "b", Any).Desc()
+
+	// --- Then ---
+	want := `[mock.MatchElements=[mock.MatchOfType=string], "b", mock.Any]`
+	assert.Equal(t, want, have)
+}
+
+func Test_MatchKeys_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		have any
+		want bool
+	}{
+		{"all keys", map[string]int{"a": 1, "b": 2}, true},
+		{"extra keys", map[string]int{"a": 1, "b": 2, "c": 3}, true},
+		{"missing key", map[string]int{"a": 1}, false},
+		{"empty map", map[string]int{}, false},
+		{"not supported type", []string{"a", "b"}, false},
+		{"nil", nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := MatchKeys("a", "b").Match(tc.have)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_MatchKeys(t *testing.T) {
+	// --- When ---
+	have := MatchKeys("a", AnyString).Desc()
+
+	// --- Then ---
+	assert.Equal(t, `[mock.MatchKeys="a", [mock.MatchOfType=string]]`, have)
+}
+
+func Test_MatchEqual(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		// --- Given ---
+		want := testcases.TIntStr{Int: 1, Str: "a"}
+
+		// --- When ---
+		have := MatchEqual(want).Match(testcases.TIntStr{Int: 1, Str: "a"})
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not match", func(t *testing.T) {
+		// --- Given ---
+		want := testcases.TIntStr{Int: 1, Str: "a"}
+
+		// --- When ---
+		have := MatchEqual(want).Match(testcases.TIntStr{Int: 1, Str: "b"})
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- Given ---
+		want := testcases.TIntStr{Int: 1, Str: "a"}
+		opt := check.WithSkipTrail("TIntStr.Str")
+
+		// --- When ---
+		have := MatchEqual(want, opt).Match(testcases.TIntStr{Int: 1, Str: "b"})
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("description", func(t *testing.T) {
+		// --- When ---
+		have := MatchEqual(testcases.TIntStr{Int: 1, Str: "a"}).Desc()
+
+		// --- Then ---
+		want := `[mock.MatchEqual=testcases.TIntStr{Int:1, Str:"a"}]`
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_MatchFields(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchFields(map[string]any{
+			"Int":     1,
+			"Str":     AnyString,
+			"TAp.Int": 2,
+			"Dur":     Any,
+		})
+		have := &testcases.TA{Int: 1, Str: "a", TAp: &testcases.TA{Int: 2}}
+
+		// --- When ---
+		match := mch.Match(have)
+
+		// --- Then ---
+		assert.True(t, match)
+	})
+
+	t.Run("not match", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchFields(map[string]any{"Int": 1, "TAp.Int": 2})
+		have := testcases.TA{Int: 1, TAp: &testcases.TA{Int: 3}}
+
+		// --- When ---
+		match := mch.Match(have)
+
+		// --- Then ---
+		assert.False(t, match)
+	})
+
+	t.Run("not existing path", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchFields(map[string]any{"TAp.Int": Any})
+		have := testcases.TA{}
+
+		// --- When ---
+		match := mch.Match(have)
+
+		// --- Then ---
+		assert.False(t, match)
+	})
+
+	t.Run("description", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchFields(map[string]any{"Str": AnyString, "Int": 1})
+
+		// --- When ---
+		have := mch.Desc()
+
+		// --- Then ---
+		want := "[mock.MatchFields=Int: 1, Str: [mock.MatchOfType=string]]"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("diff shows failed paths", func(t *testing.T) {
+		// --- Given ---
+		mch := MatchFields(map[string]any{
+			"Int":     1,
+			"Str":     MatchLen(1),
+			"TAp.Int": 2,
+			"TAp.Str": "b",
+		})
+		have := testcases.TA{Int: 1, Str: "abc"}
+
+		// --- When ---
+		diff, cnt := Arguments{mch}.Diff([]any{have})
+
+		// --- Then ---
+		assert.Equal(t, 1, cnt)
+		assert.Len(t, 1, diff)
+		assert.Contain(t, "0: FAIL:", diff[0])
+		want := "" +
+			"    FAIL: Str: [mock.MatchLen=1] != (string=\"abc\")\n" +
+			"    FAIL: TAp.Int: (int=2) != (Missing)\n" +
+			"    FAIL: TAp.Str: (string=\"b\") != (Missing)"
+		assert.Contain(t, want, diff[0])
+	})
+}
+
+func Test_fieldValue_tabular(t *testing.T) {
+	val := testcases.TNested{
+		SInt:    []int{1, 2},
+		STA:     []testcases.TA{{Str: "a"}},
+		STAp:    []*testcases.TA{{Int: 3}, nil},
+		MStrInt: map[string]int{"a.b]": 4},
+		MIntTyp: map[int]testcases.TA{-5: {Str: "c"}},
+	}
+
+	tt := []struct {
+		testN string
+
+		pth  string
+		want any
+		ok   bool
+	}{
+		{"field", "SInt", []int{1, 2}, true},
+		{"slice element", "SInt[1]", 2, true},
+		{"struct slice element field", "STA[0].Str", "a", true},
+		{"pointer slice element field", "STAp[0].Int", 3, true},
+		{"nil pointer", "STAp[1].Int", nil, false},
+		{"string map key", `MStrInt["a.b]"]`, 4, true},
+		{"int map key", "MIntTyp[-5].Str", "c", true},
+		{"not existing field", "Abc", nil, false},
+		{"field of not struct", "SInt.Abc", nil, false},
+		{"index out of range", "SInt[2]", nil, false},
+		{"negative index", "SInt[-1]", nil, false},
+		{"quoted index", `SInt["1"]`, nil, false},
+		{"not existing map key", `MStrInt["x"]`, nil, false},
+		{"not quoted string map key", "MStrInt[a]", nil, false},
+		{"quoted int map key", `MIntTyp["1"]`, nil, false},
+		{"invalid int map key", "MIntTyp[a]", nil, false},
+		{"not closed index", "SInt[1", nil, false},
+		{"not closed quoted key", `MStrInt["a"`, nil, false},
+		{"index of not indexable", "STA[0].Str[0]", nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, ok := fieldValue(reflect.ValueOf(val), tc.pth)
+
+			// --- Then ---
+			assert.Equal(t, tc.ok, ok)
+			if tc.ok {
+				assert.Equal(t, tc.want, have.Interface())
+			}
+		})
+	}
+}
@@ -18,17 +18,24 @@
 //   - [NewMock] and [Mock] — the core mock controller
 //   - [Mock.On], [Mock.OnAny], [Mock.Proxy] — define expectations
 //   - [Call] and its chain methods (Return, Times, Until, ...)
+//   - [InOrder] and [Sequence] — call order verification across mocks
+//   - [Mock.Calls], [Mock.AssertCalledWith], [Mock.AssertNotCalled] — call
+//     history inspection
+//   - [Mock.WaitFor], [Call.WaitSatisfied] — waiting for asynchronous calls
+//   - [Mock.ExpectationsReport] — table of all expectations and their state
 //   - [Arguments] — typed getters for return values and call recording
 //   - Matchers: [Any], [AnyString], [MatchBy], [MatchOfType], [MatchError], ...
+//   - [Capture] — argument captors
 package mock
 
 import (
 	"errors"
-	"fmt"
 	"reflect"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ctx42/testing/pkg/dump"
 	"github.com/ctx42/testing/pkg/notice"
@@ -56,6 +63,18 @@ var (
 	// ErrNotFound is returned when no matching expectation was found for a
 	// call (see [Mock.Call] and [Mock.Called]).
 	ErrNotFound = errors.New("method not found")
+
+	// ErrOrder is returned when a call belonging to a [Sequence] is made out
+	// of order.
+	ErrOrder = errors.New("method called out of order")
+
+	// ErrNotCalledWith is returned when a method was not called with the
+	// expected arguments (see [Mock.AssertCalledWith]).
+	ErrNotCalledWith = errors.New("method not called with expected arguments")
+
+	// ErrUnexpectedCall is returned when a method was called with arguments it
+	// was not expected to be called with (see [Mock.AssertNotCalled]).
+	ErrUnexpectedCall = errors.New("unexpected method call")
 )
 
 const (
@@ -72,11 +91,17 @@ const (
 	hTooManyCalls   = "[mock] too many method calls"
 	hUnexpectedCall = "[mock] unexpected method call"
 	hNotFoundCall   = "[mock] method call not found"
+	hOutOfOrder     = "[mock] method called out of order"
+	hNotCalledWith  = "[mock] method not called with expected arguments"
+	hWaitTimeout    = "[mock] timeout waiting for method call"
 )
 
 // dumper is the default value renderer used for diagnostic output.
 var dumper = dump.New()
 
+// flatDumper is the single line value renderer used for diagnostic output.
+var flatDumper = dump.New(dump.WithFlat, dump.WithCompact)
+
 // Option configures a [Mock] created by [NewMock].
 type Option func(*Mock)
 
@@ -84,6 +109,35 @@ type Option func(*Mock)
 // the mock when expectations are violated.
 func WithNoStack(mck *Mock) { mck.stack = false }
 
+// WithNice makes the mock "nice". A nice mock doesn't fail the test when a
+// method is called without a matching expectation. Instead, the call is
+// recorded (see [Invocation.Unexpected]) and the method returns zero values
+// for its result types.
+//
+// The result types are taken from the method signature of the proxy call
+// registered with [Mock.Proxy] or, when there is none, from the method of
+// the same name on the value set with [WithOwner]. The owner is required,
+// [NewMock] panics when the mock is nice and has no owner. When the owner has
+// no method with the name and there is no proxy call, the call returns no
+// values.
+//
+// Calls matching an expectation are handled as usual, so exceeding the number
+// of allowed calls or breaking the call order still fails the test.
+func WithNice(mck *Mock) { mck.nice = true }
+
+// WithReport makes the mock log the expectations report (see
+// [Mock.ExpectationsReport]) every time it fails the test. The report is
+// logged right before the failure message. It's not logged when
+// [Mock.AssertExpectations] fails, since its message already is the report.
+func WithReport(mck *Mock) { mck.report = true }
+
+// WithOwner sets the value embedding the mock, usually the generated mock
+// instance. The mock uses it to find the method signatures when it needs them
+// (see [WithNice]). Only exported methods of the owner are considered.
+func WithOwner(owner any) Option {
+	return func(mck *Mock) { mck.owner = owner }
+}
+
 // Mock tracks expected and actual calls on a mocked interface.
 //
 // A [Mock] is typically embedded in a hand-written or generated *Mock struct
@@ -95,7 +149,7 @@ type Mock struct {
 	expected []*Call
 
 	// Calls made on the mock.
-	calls []cStack
+	calls []Invocation
 
 	// Holds any data that might be useful for testing. The Mock ignores it,
 	// allowing you to do whatever you like with it.
@@ -106,6 +160,16 @@ type Mock struct {
 	// would want to set it to false. Do you?
 	stack bool
 
+	// When true, calls without matching expectations return zero values
+	// instead of failing the test.
+	nice bool
+
+	// The value embedding the mock. Used to find the method signatures.
+	owner any
+
+	// When true, the expectations report is logged on every failure.
+	report bool
+
 	// Set to true if mock is in a failed state.
 	failed bool
 
@@ -120,13 +184,19 @@ type Mock struct {
 //
 // The mock registers an automatic cleanup that invokes
 // [Mock.AssertExpectations] when the test completes. Use the [Option]
-// functions (currently only [WithNoStack]) to customize behavior.
+// functions ([WithNoStack], [WithNice], [WithReport], [WithOwner]) to
+// customize behavior.
+//
+// It panics when the [WithNice] option is used without the [WithOwner].
 func NewMock(t tester.T, opts ...Option) *Mock {
 	t.Helper()
 	mck := &Mock{t: t, stack: true}
 	for _, opt := range opts {
 		opt(mck)
 	}
+	if mck.nice && mck.owner == nil {
+		panic("mock.WithNice requires mock.WithOwner")
+	}
 	t.Cleanup(func() { t.Helper(); mck.AssertExpectations() })
 	return mck
 }
@@ -242,9 +312,9 @@ func (mck *Mock) called(skip int) string {
 // go through generated wrappers that call [Mock.Called]).
 //
 // The call blocks if the matching expectation uses [Call.Until] or [Call.After].
+// The mock is not locked while the call blocks, so it may be inspected and
+// called from other goroutines in the meantime.
 func (mck *Mock) Call(method string, args ...any) Arguments {
-	mck.mx.Lock()
-	defer mck.mx.Unlock()
 	mck.t.Helper()
 
 	var cs []string
@@ -252,19 +322,108 @@ func (mck *Mock) Call(method string, args ...any) Arguments {
 		cs = callStack()
 	}
 
+	call, idx, n, rets := mck.record(method, args, cs)
+	if call == nil {
+		return rets
+	}
+
+	rets = call.run(n, args)
+
+	mck.mx.Lock()
+	defer mck.mx.Unlock()
+	mck.calls[idx].Returns = rets
+	return rets
+}
+
+// record finds the expectation matching the method call, counts the call and
+// records the invocation. It returns the expectation, the index of the
+// recorded invocation, and the call ordinal (see [Call.count]). For nice
+// mocks, when there is no matching expectation, it returns nil [Call] and
+// zero values the method should return.
+func (mck *Mock) record(
+	method string,
+	args []any,
+	cs []string,
+) (*Call, int, int, Arguments) {
+
+	mck.mx.Lock()
+	defer mck.mx.Unlock()
+	mck.t.Helper()
+
 	call, err := mck.find(method, args, cs)
 	if err != nil {
+		if mck.nice && errors.Is(err, ErrNotFound) {
+			return nil, 0, 0, mck.callNice(method, args, cs)
+		}
 		mck.failed = true
+		mck.logReport()
 		mck.t.Fatal(err)
 	}
 
 	if err = call.checkReq(cs); err != nil {
 		mck.failed = true
+		mck.logReport()
+		mck.t.Fatal(err)
+	}
+
+	if err = call.checkSeq(cs); err != nil {
+		mck.failed = true
+		mck.logReport()
 		mck.t.Fatal(err)
 	}
 
-	mck.calls = append(mck.calls, cStack{Method: method, Stack: cs})
-	return call.call(args...)
+	idx := len(mck.calls)
+	mck.calls = append(mck.calls, Invocation{
+		Method:    method,
+		Args:      slices.Clone(args),
+		Time:      time.Now(),
+		Goroutine: goroutineID(),
+		Stack:     cs,
+	})
+	n := call.count(args)
+	return call, idx, n, nil
+}
+
+// callNice records the call of a method without a matching expectation made
+// on a nice mock and returns zero values for the method result types.
+func (mck *Mock) callNice(method string, args []any, cs []string) Arguments {
+	rets := mck.zeros(method)
+	mck.calls = append(mck.calls, Invocation{
+		Method:     method,
+		Args:       slices.Clone(args),
+		Returns:    rets,
+		Time:       time.Now(),
+		Goroutine:  goroutineID(),
+		Stack:      cs,
+		Unexpected: true,
+	})
+	return slices.Clone(rets)
+}
+
+// zeros returns zero values for the result types of the named method. The
+// signature is taken from the proxy call with the same name or from the owner
+// method. Returns nil when the signature cannot be found.
+func (mck *Mock) zeros(method string) Arguments {
+	var typ reflect.Type
+	for _, call := range mck.expected {
+		if call.Method == method && call.proxy.IsValid() {
+			typ = call.proxy.Type()
+			break
+		}
+	}
+	if typ == nil && mck.owner != nil {
+		if met := reflect.ValueOf(mck.owner).MethodByName(method); met.IsValid() {
+			typ = met.Type()
+		}
+	}
+	if typ == nil || typ.NumOut() == 0 {
+		return nil
+	}
+	rets := make(Arguments, typ.NumOut())
+	for i := range rets {
+		rets[i] = reflect.Zero(typ.Out(i)).Interface()
+	}
+	return rets
 }
 
 // Callable reports whether a method with the given name and arguments can be
@@ -274,11 +433,16 @@ func (mck *Mock) Call(method string, args ...any) Arguments {
 //
 // This is useful for introspection or custom test logic; normal usage goes
 // through [Mock.Called] / [Mock.Call].
+//
+// For nice mocks (see [WithNice]) the missing expectation is not an error.
 func (mck *Mock) Callable(method string, args ...any) error {
 	mck.mx.Lock()
 	defer mck.mx.Unlock()
 	mck.t.Helper()
 	_, err := mck.find(method, args, nil)
+	if mck.nice && errors.Is(err, ErrNotFound) {
+		return nil
+	}
 	return err
 }
 
@@ -288,11 +452,23 @@ func (mck *Mock) Callable(method string, args ...any) error {
 // criteria in which case the first one is returned.
 //
 // A callable method is one that returns no error from [Call.CanCall] method,
-// and has matching arguments.
+// has matching arguments, and can be called without breaking the order of the
+// sequence it belongs to. When all matching calls break their sequences, the
+// order violation error of the first one is returned.
 //
 // nolint: cyclop
 func (mck *Mock) find(method string, args []any, cs []string) (*Call, error) {
-	var err error
+	var err, ordErr error
+
+	// inOrder reports whether the call can be made without breaking its
+	// sequence. Remembers the first order violation.
+	inOrder := func(call *Call) bool {
+		oErr := call.inOrder(cs)
+		if oErr != nil && ordErr == nil {
+			ordErr = oErr
+		}
+		return oErr == nil
+	}
 
 	// Find a method (including proxies) with matching arguments.
 	for _, call := range mck.expected {
@@ -304,10 +480,13 @@ func (mck *Mock) find(method string, args []any, cs []string) (*Call, error) {
 			continue
 		}
 		if call.argsAny && err == nil {
-			return call, nil
+			if inOrder(call) {
+				return call, nil
+			}
+			continue
 		}
 		if _, cnt := call.args.Diff(args); cnt == 0 {
-			if err == nil {
+			if err == nil && inOrder(call) {
 				return call, nil
 			}
 		}
@@ -320,12 +499,17 @@ func (mck *Mock) find(method string, args []any, cs []string) (*Call, error) {
 			continue
 		}
 		if call.proxy.IsValid() && len(call.args) == 0 {
-			if err = call.CanCall(); err == nil {
+			if err = call.CanCall(); err == nil && inOrder(call) {
 				return call, nil
 			}
 		}
 	}
 
+	// All the matching calls would break their sequences.
+	if ordErr != nil {
+		return nil, ordErr
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -410,6 +594,7 @@ func (mck *Mock) Unset(remove *Call) *Mock {
 		msg := notice.New("[mock] unsetting non-existing method").
 			Append("method", "%s", method).
 			Wrap(ErrNotFound)
+		mck.logReport()
 		mck.t.Error(msg)
 	}
 	return mck
@@ -422,6 +607,13 @@ func (mck *Mock) Unset(remove *Call) *Mock {
 //
 // Returns true when all expectations are met. On failure it records the
 // problem via t.Error (or t.Fatal for unexpected calls) and returns false.
+// The failure message lists all the expectations in the same table as the
+// expectations report (see [Mock.ExpectationsReport]):
+//
+//	[mock] too few method calls:
+//	  #: method  args     want  have  optional  satisfied
+//	  0: Open    "a.txt"  1     1     no        yes
+//	  1: Read    ...      >=1   0     no        no
 func (mck *Mock) AssertExpectations() bool {
 	mck.mx.Lock()
 	defer mck.mx.Unlock()
@@ -431,39 +623,12 @@ func (mck *Mock) AssertExpectations() bool {
 		return false
 	}
 
-	var names, whys []string
-	for _, call := range mck.expected {
-		if call.Satisfied() {
-			continue
-		}
-		wCls := "calls"
-		if call.wantCalls == 1 {
-			wCls = "call"
-		}
-		hCls := "calls"
-		if call.haveCalls == 1 {
-			hCls = "call"
-		}
-
-		names = append(names, call.Method)
-		var why string
-		if call.wantCalls == 0 {
-			format := "expected at least one call received %d %s"
-			why = fmt.Sprintf(format, call.haveCalls, hCls)
-		} else {
-			format := "expected %d %s received %d %s"
-			why = fmt.Sprintf(format, call.wantCalls, wCls, call.haveCalls, hCls)
-		}
-		whys = append(whys, why)
-	}
-	if len(names) == 0 {
+	unsatisfied := func(call *Call) bool { return !call.Satisfied() }
+	if !slices.ContainsFunc(mck.expected, unsatisfied) {
 		mck.failed = false
 		return true
 	}
-	twoColumns(names, whys)
-	msg := notice.New(hTooFewCalls).
-		Append("missing calls", "\n%s", strings.Join(names, "\n")).
-		Wrap(ErrTooFewCalls)
+	msg := mck.reportRows(notice.New(hTooFewCalls)).Wrap(ErrTooFewCalls)
 
 	mck.failed = true
 	mck.t.Error(msg)
@@ -473,6 +638,10 @@ func (mck *Mock) AssertExpectations() bool {
 // AssertCallCount asserts that the named method was invoked exactly "want"
 // times. Useful when you only care about call count rather than full
 // expectation configuration.
+//
+// For nice mocks (see [WithNice]) the count includes the calls without
+// a matching expectation. Use [Mock.Calls] and [Invocation.Unexpected] to
+// tell them apart.
 func (mck *Mock) AssertCallCount(method string, want int) bool {
 	mck.mx.Lock()
 	defer mck.mx.Unlock()
@@ -496,6 +665,150 @@ func (mck *Mock) AssertCallCount(method string, want int) bool {
 	_ = msg.Append("method", "%s", method).
 		Append("want calls", "%d", want).
 		Append("have calls", "%d", have)
+	mck.logReport()
+	mck.t.Error(msg)
+	mck.failed = true
+	return false
+}
+
+// WaitFor blocks until the expectation is satisfied (see [Call.Satisfied]) but
+// no longer than the given duration. It's useful when the code under test
+// calls the mock from other goroutines, and the expectations would otherwise
+// be verified before the calls are made.
+//
+// Returns true when the expectation was satisfied in time. On timeout, or when
+// the method was called too many times, it records the unsatisfied
+// expectation via t.Error and returns false. See [Call.WaitSatisfied] for
+// details.
+//
+// Example:
+//
+//	call := mck.On("Notify", "done").Once()
+//	go svc.Run()
+//	mck.WaitFor(call, time.Second)
+func (mck *Mock) WaitFor(call *Call, within time.Duration) bool {
+	mck.t.Helper()
+	if call == nil {
+		panic("a nil instance of mock.Call passed to mock.Mock.WaitFor")
+	}
+	if err := call.WaitSatisfied(within); err != nil {
+		mck.mx.Lock()
+		defer mck.mx.Unlock()
+		mck.failed = true
+		mck.logReport()
+		mck.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// Calls returns the recorded calls of the named method in the order they were
+// made. Returns nil when the method was never called.
+//
+// Example:
+//
+//	calls := mck.Calls("Write")
+//	assert.Equal(t, mock.Arguments{"abc"}, calls[0].Args)
+func (mck *Mock) Calls(method string) []Invocation {
+	mck.mx.Lock()
+	defer mck.mx.Unlock()
+	var calls []Invocation
+	for _, call := range mck.calls {
+		if call.Method == method {
+			call.Args = slices.Clone(call.Args)
+			call.Returns = slices.Clone(call.Returns)
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertCalledWith asserts that the named method was called at least once
+// with arguments matching "args". The arguments are matched the same way as
+// in [Mock.On], so [Any] and matchers may be used. On failure, it reports the
+// argument difference for the closest recorded call.
+func (mck *Mock) AssertCalledWith(method string, args ...any) bool {
+	mck.mx.Lock()
+	defer mck.mx.Unlock()
+	mck.t.Helper()
+
+	var have int
+	var best []string
+	bestCnt := -1
+	for _, call := range mck.calls {
+		if call.Method != method {
+			continue
+		}
+		have++
+		diff, cnt := Arguments(args).Diff(call.Args)
+		if cnt == 0 {
+			return true
+		}
+		if bestCnt == -1 || cnt < bestCnt {
+			best, bestCnt = diff, cnt
+		}
+	}
+
+	var msg *notice.Notice
+	if have == 0 {
+		msg = notice.New(hNeverCalled).
+			Append("method", "%s", formatMethod(method, args, nil))
+		if len(args) > 0 {
+			_ = msg.Append("expected args", "\n%s", formatArgs(args))
+		}
+		_ = msg.Wrap(ErrNeverCalled)
+	} else {
+		msg = notice.New(hNotCalledWith).
+			Append("method", "%s", formatMethod(method, args, nil))
+		if len(args) > 0 {
+			_ = msg.Append("expected args", "\n%s", formatArgs(args))
+		}
+		_ = msg.Append("have calls", "%d", have).
+			Append("closest match", "\n%s", strings.Join(best, "\n")).
+			Wrap(ErrNotCalledWith)
+	}
+	mck.logReport()
+	mck.t.Error(msg)
+	mck.failed = true
+	return false
+}
+
+// AssertNotCalled asserts that the named method was never called with
+// arguments matching "args". The arguments are matched the same way as in
+// [Mock.On], so [Any] and matchers may be used. To assert the method was
+// never called with any arguments use [Mock.AssertCallCount] with zero.
+func (mck *Mock) AssertNotCalled(method string, args ...any) bool {
+	mck.mx.Lock()
+	defer mck.mx.Unlock()
+	mck.t.Helper()
+
+	var have int
+	var first Invocation
+	for _, call := range mck.calls {
+		if call.Method != method {
+			continue
+		}
+		if _, cnt := Arguments(args).Diff(call.Args); cnt == 0 {
+			if have == 0 {
+				first = call
+			}
+			have++
+		}
+	}
+	if have == 0 {
+		return true
+	}
+
+	msg := notice.New(hUnexpectedCall).
+		Append("method", "%s", formatMethod(method, first.Args, nil))
+	if len(first.Args) > 0 {
+		_ = msg.Append("with args", "\n%s", formatArgs(first.Args))
+	}
+	_ = msg.Append("have calls", "%d", have).Wrap(ErrUnexpectedCall)
+	if len(first.Stack) > 0 {
+		_ = msg.Append("stack", "\n%s", strings.Join(first.Stack, "\n"))
+	}
+	mck.logReport()
 	mck.t.Error(msg)
 	mck.failed = true
 	return false
@@ -5,6 +5,7 @@ package mock
 
 import (
 	"errors"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -26,6 +27,40 @@ func Test_WithNoStack(t *testing.T) {
 	assert.False(t, mck.stack)
 }
 
+func Test_WithNice(t *testing.T) {
+	// --- Given ---
+	mck := &Mock{}
+
+	// --- When ---
+	WithNice(mck)
+
+	// --- Then ---
+	assert.True(t, mck.nice)
+}
+
+func Test_WithReport(t *testing.T) {
+	// --- Given ---
+	mck := &Mock{}
+
+	// --- When ---
+	WithReport(mck)
+
+	// --- Then ---
+	assert.True(t, mck.report)
+}
+
+func Test_WithOwner(t *testing.T) {
+	// --- Given ---
+	mck := &Mock{}
+	owner := &ExampleImpl{}
+
+	// --- When ---
+	WithOwner(owner)(mck)
+
+	// --- Then ---
+	assert.Same(t, owner, mck.owner)
+}
+
 func Test_NewMock(t *testing.T) {
 	t.Run("no expectations", func(t *testing.T) {
 		// --- Given ---
@@ -42,6 +77,9 @@ func Test_NewMock(t *testing.T) {
 		assert.Len(t, 0, mck.calls)
 		assert.Equal(t, 0, len(mck.meta))
 		assert.True(t, mck.stack)
+		assert.False(t, mck.nice)
+		assert.Nil(t, mck.owner)
+		assert.False(t, mck.report)
 		assert.False(t, mck.failed)
 		assert.Same(t, tspy, mck.t)
 	})
@@ -78,6 +116,18 @@ func Test_NewMock(t *testing.T) {
 		tspy.Finish()
 		assert.False(t, mck.stack)
 	})
+
+	t.Run("panics when nice without owner", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		// --- When ---
+		msg := assert.PanicMsg(t, func() { NewMock(tspy, WithNice) })
+
+		// --- Then ---
+		assert.Equal(t, "mock.WithNice requires mock.WithOwner", *msg)
+	})
 }
 
 func Test_Mock_MetaSetAll(t *testing.T) {
@@ -673,6 +723,64 @@ func Test_Mock_Call(t *testing.T) {
 		assert.True(t, mck.failed)
 	})
 
+	t.Run("return sequence with times", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectFail()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		errT := errors.New("timeout")
+		mck := NewMock(tspy)
+		mck.On("Get", "key").ReturnSeq(
+			Arguments{"", errT},
+			Arguments{"", errT},
+			Arguments{"value", nil},
+		).Times(3)
+
+		// --- When ---
+		have0 := mck.Call("Get", "key")
+		have1 := mck.Call("Get", "key")
+		have2 := mck.Call("Get", "key")
+
+		// --- Then ---
+		assert.Equal(t, Arguments{"", errT}, have0)
+		assert.Equal(t, Arguments{"", errT}, have1)
+		assert.Equal(t, Arguments{"value", nil}, have2)
+		assert.True(t, mck.AssertExpectations())
+		assert.Panic(t, func() { mck.Call("Get", "key") })
+	})
+
+	t.Run("return sequence with concurrent blocked calls", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		ch := make(chan time.Time)
+		mck := NewMock(tspy)
+		call := mck.On("Get").
+			ReturnSeq(Arguments{1}, Arguments{2}).
+			Until(ch).
+			Times(2)
+
+		haves := make(chan int, 2)
+		for range 2 {
+			go func() { haves <- mck.Call("Get").Int(0) }()
+		}
+		assert.True(t, mck.WaitFor(call, time.Second))
+
+		// --- When ---
+		close(ch)
+
+		// --- Then ---
+		have := []int{<-haves, <-haves}
+		slices.Sort(have)
+		assert.Equal(t, []int{1, 2}, have)
+		assert.False(t, mck.failed)
+	})
+
 	t.Run("wait for until", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
@@ -865,6 +973,105 @@ func Test_Mock_Call(t *testing.T) {
 		assert.Equal(t, "b a [1 2 3]", have[0])
 		assert.False(t, mck.failed)
 	})
+
+	t.Run("nice - owner zero values", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		imp := &ExampleImpl{}
+		imp.Mock = NewMock(tspy, WithNice, WithOwner(imp))
+
+		// --- When ---
+		have, err := imp.MethodInts(1, 2, 3)
+
+		// --- Then ---
+		assert.Equal(t, 0, have)
+		assert.Equal(t, "whoops", err.Error())
+		assert.False(t, imp.failed)
+
+		assert.Len(t, 1, imp.calls)
+		assert.Equal(t, "MethodInts", imp.calls[0].Method)
+		assert.Equal(t, Arguments{1, 2, 3}, imp.calls[0].Args)
+		assert.Equal(t, Arguments{0, nil}, imp.calls[0].Returns)
+		assert.True(t, imp.calls[0].Unexpected)
+	})
+
+	t.Run("nice - proxy zero values", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy, WithNice, WithOwner(&ExampleImpl{}))
+		ptr := &testcases.TPtr{Val: "b"}
+		mck.Proxy(ptr.Wrap).With("a", "c").Optional()
+
+		// --- When ---
+		have := mck.Call("Wrap", "x", "y")
+
+		// --- Then ---
+		assert.Equal(t, Arguments{""}, have)
+		assert.False(t, mck.failed)
+		assert.Len(t, 1, mck.calls)
+		assert.True(t, mck.calls[0].Unexpected)
+	})
+
+	t.Run("nice - unknown signature", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy, WithNice, WithOwner(&ExampleImpl{}))
+
+		// --- When ---
+		have := mck.Call("Zero", 0)
+
+		// --- Then ---
+		assert.Nil(t, have)
+		assert.False(t, mck.failed)
+		assert.Len(t, 1, mck.calls)
+		assert.True(t, mck.calls[0].Unexpected)
+	})
+
+	t.Run("nice - matching expectation is used", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy, WithNice, WithOwner(&ExampleImpl{}))
+		call0 := mck.On("Zero", 0).Return("zero")
+
+		// --- When ---
+		have := mck.Call("Zero", 0)
+
+		// --- Then ---
+		assert.Equal(t, Arguments{"zero"}, have)
+		assert.Equal(t, 1, call0.haveCalls)
+		assert.Len(t, 1, mck.calls)
+		assert.False(t, mck.calls[0].Unexpected)
+	})
+
+	t.Run("nice - error when called too many times", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectFail()
+		wMsg := goldy.Open(t, "testdata/mock_too_many_calls.gld")
+		tspy.ExpectLogEqual(wMsg.String())
+		tspy.Close()
+
+		mck := NewMock(tspy, WithNice, WithOwner(&ExampleImpl{}))
+		mck.On("Zero", 0, 1).Once()
+		mck.Call("Zero", 0, 1)
+
+		// --- When ---
+		assert.Panic(t, func() { mck.Call("Zero", 0, 1) })
+		assert.True(t, mck.failed)
+	})
 }
 
 func Test_Mock_Callable(t *testing.T) {
@@ -938,6 +1145,22 @@ func Test_Mock_Callable(t *testing.T) {
 		// --- Then ---
 		assert.ErrorIs(t, ErrTooManyCalls, err)
 	})
+
+	t.Run("nice - not existing method is callable", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := &ExampleImpl{}
+		mck.Mock = NewMock(tspy, WithNice, WithOwner(mck))
+
+		// --- When ---
+		err := mck.Callable("NotExisting")
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
 }
 
 func Test_Mock_find(t *testing.T) {
@@ -1566,6 +1789,26 @@ func Test_Mock_AssertCallCount(t *testing.T) {
 		assert.False(t, mck.failed)
 	})
 
+	t.Run("nice - counts unexpected calls", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := &ExampleImpl{}
+		mck.Mock = NewMock(tspy, WithNice, WithOwner(mck))
+		mck.On("MethodIntVar", 42).Return(nil)
+		mck.MethodIntVar(42)
+		mck.MethodIntVar(44)
+
+		// --- When ---
+		have := mck.AssertCallCount("MethodIntVar", 2)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.False(t, mck.failed)
+	})
+
 	t.Run("error - when method called too few times", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
@@ -1609,6 +1852,309 @@ func Test_Mock_AssertCallCount(t *testing.T) {
 	})
 }
 
+func Test_Mock_WaitFor(t *testing.T) {
+	t.Run("satisfied", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		call := mck.On("Zero", 0).Once()
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			mck.Call("Zero", 0)
+		}()
+
+		// --- When ---
+		have := mck.WaitFor(call, time.Second)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.False(t, mck.failed)
+	})
+
+	t.Run("error - timeout", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := goldy.Open(t, "testdata/wait_timeout_never.gld")
+		tspy.ExpectLogEqual(wMsg.String())
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		call := mck.On("Zero")
+
+		// --- When ---
+		have := mck.WaitFor(call, 10*time.Millisecond)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.True(t, mck.failed)
+	})
+
+	t.Run("error - timeout while other call blocks", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := goldy.Open(t, "testdata/wait_timeout_never.gld")
+		tspy.ExpectLogEqual(wMsg.String())
+		tspy.Close()
+
+		never := make(chan time.Time)
+		defer close(never)
+
+		mck := NewMock(tspy)
+		block := mck.On("Block").Until(never)
+		call := mck.On("Zero")
+		go mck.Call("Block")
+		assert.True(t, mck.WaitFor(block, time.Second))
+
+		// --- When ---
+		have := mck.WaitFor(call, 10*time.Millisecond)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.True(t, mck.Failed())
+	})
+
+	t.Run("panics when call is nil", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy)
+
+		// --- When ---
+		msg := assert.PanicMsg(t, func() { mck.WaitFor(nil, time.Second) })
+
+		// --- Then ---
+		wMsg := "a nil instance of mock.Call passed to mock.Mock.WaitFor"
+		assert.Equal(t, wMsg, *msg)
+	})
+}
+
+func Test_Mock_Calls(t *testing.T) {
+	t.Run("recorded calls", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewExampleImpl(NewMock(tspy))
+		mck.On("MethodInts", 1, 2, 3).Return(6)
+		mck.On("MethodInts", 4, 5, 6).Return(15)
+		mck.On("MethodBool", true)
+		start := time.Now()
+
+		_, _ = mck.MethodInts(1, 2, 3)
+		mck.MethodBool(true)
+		_, _ = mck.MethodInts(4, 5, 6)
+
+		// --- When ---
+		have := mck.Calls("MethodInts")
+
+		// --- Then ---
+		assert.Len(t, 2, have)
+		assert.Equal(t, "MethodInts", have[0].Method)
+		assert.Equal(t, Arguments{1, 2, 3}, have[0].Args)
+		assert.Equal(t, Arguments{6}, have[0].Returns)
+		assert.Within(t, start, "1s", have[0].Time)
+		assert.Equal(t, goroutineID(), have[0].Goroutine)
+		assert.Contain(t, "mock_test.go", have[0].Stack[len(have[0].Stack)-1])
+
+		assert.Equal(t, "MethodInts", have[1].Method)
+		assert.Equal(t, Arguments{4, 5, 6}, have[1].Args)
+		assert.Equal(t, Arguments{15}, have[1].Returns)
+		assert.True(t, !have[1].Time.Before(have[0].Time))
+	})
+
+	t.Run("arguments are recorded before altering", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy, WithNoStack)
+		mck.On("Method", 1).Alter(func(args Arguments) { args[0] = 2 })
+		mck.Call("Method", 1)
+
+		// --- When ---
+		have := mck.Calls("Method")
+
+		// --- Then ---
+		assert.Len(t, 1, have)
+		assert.Equal(t, Arguments{1}, have[0].Args)
+		assert.Nil(t, have[0].Returns)
+		assert.Nil(t, have[0].Stack)
+	})
+
+	t.Run("returns copies", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		mck.On("Method", 1).Return(2)
+		mck.Call("Method", 1)
+
+		// --- When ---
+		have := mck.Calls("Method")
+		have[0].Args[0] = 3
+		have[0].Returns[0] = 4
+
+		// --- Then ---
+		again := mck.Calls("Method")
+		assert.Equal(t, Arguments{1}, again[0].Args)
+		assert.Equal(t, Arguments{2}, again[0].Returns)
+	})
+
+	t.Run("method never called", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		mck.On("Method").Optional()
+
+		// --- When ---
+		have := mck.Calls("Method")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Mock_AssertCalledWith(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		mck.On("Method", AnyInt, AnyString)
+		mck.Call("Method", 1, "a")
+		mck.Call("Method", 2, "b")
+
+		// --- Then ---
+		assert.True(t, mck.AssertCalledWith("Method", 2, "b"))
+		assert.True(t, mck.AssertCalledWith("Method", Any, "a"))
+		assert.True(t, mck.AssertCalledWith("Method", AnyInt, AnyString))
+		assert.False(t, mck.failed)
+	})
+
+	t.Run("error - not called with arguments", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectFail()
+		wMsg := goldy.Open(t, "testdata/assert_called_with_not_called.gld")
+		tspy.ExpectLogEqual(wMsg.String())
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		mck.On("Method", AnyInt, AnyString)
+		mck.Call("Method", 1, "a")
+		mck.Call("Method", 2, "b")
+
+		// --- When ---
+		have := mck.AssertCalledWith("Method", 2, "c")
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.True(t, mck.failed)
+	})
+
+	t.Run("error - never called", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectFail()
+		wMsg := goldy.Open(t, "testdata/assert_called_with_never.gld")
+		tspy.ExpectLogEqual(wMsg.String())
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		mck.On("Method", AnyInt).Optional()
+
+		// --- When ---
+		have := mck.AssertCalledWith("Method", 1)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.True(t, mck.failed)
+	})
+}
+
+func Test_Mock_AssertNotCalled(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		mck.On("Method", AnyInt)
+		mck.On("Other").Optional()
+		mck.Call("Method", 1)
+
+		// --- Then ---
+		assert.True(t, mck.AssertNotCalled("Method", 2))
+		assert.True(t, mck.AssertNotCalled("Method", 1, 2))
+		assert.True(t, mck.AssertNotCalled("Other"))
+		assert.False(t, mck.failed)
+	})
+
+	t.Run("error - called with arguments", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectFail()
+		wMsg := goldy.Open(t, "testdata/assert_not_called.gld")
+		tspy.ExpectLogEqual(wMsg.String())
+		tspy.Close()
+
+		mck := NewMock(tspy, WithNoStack)
+		mck.On("Method", AnyInt)
+		mck.Call("Method", 1)
+		mck.Call("Method", 2)
+		mck.Call("Method", 1)
+
+		// --- When ---
+		have := mck.AssertNotCalled("Method", 1)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.True(t, mck.failed)
+	})
+
+	t.Run("error - called without arguments", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectFail()
+		tspy.ExpectLogContain("[mock] unexpected method call")
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		mck.On("Method")
+		mck.Call("Method")
+
+		// --- When ---
+		have := mck.AssertNotCalled("Method")
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.True(t, mck.failed)
+	})
+}
+
 // Note: Meaningful benchmarks for the mock package require either
 // generated mocks or the mocker tool. Basic call recording benchmarks
 // are intentionally omitted for now to avoid coupling to test-only helpers.
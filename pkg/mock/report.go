@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// Expectations report headers (internal).
+const (
+	hReport = "[mock] expectations report"
+)
+
+// reportColumns are the column names of the expectations report table.
+var reportColumns = []string{
+	"method", "args", "want", "have", "optional", "satisfied",
+}
+
+// ExpectationsReport returns the report listing all the expectations
+// registered on the mock in the order they were defined. Each expectation is
+// a row of a table with the method name, expected arguments, the wanted and
+// actual number of calls, the optional flag, and whether the expectation is
+// satisfied.
+//
+// Example report:
+//
+//	[mock] expectations report:
+//	  #: method  args     want  have  optional  satisfied
+//	  0: Open    "a.txt"  1     1     no        yes
+//	  1: Read    ...      >=1   0     no        no
+//	  2: Close            any   0     yes       yes
+//
+// The "want" column shows the number of calls set with [Call.Times], ">=1"
+// for calls required at least once, and "any" for optional calls.
+func (mck *Mock) ExpectationsReport() *notice.Notice {
+	mck.mx.Lock()
+	defer mck.mx.Unlock()
+	return mck.expectationsReport()
+}
+
+// expectationsReport returns the expectations report. It must be called with
+// the mutex held.
+func (mck *Mock) expectationsReport() *notice.Notice {
+	return mck.reportRows(notice.New(hReport))
+}
+
+// reportRows appends the expectations report table rows to the message and
+// returns it. It must be called with the mutex held.
+func (mck *Mock) reportRows(msg *notice.Notice) *notice.Notice {
+	if len(mck.expected) == 0 {
+		return msg
+	}
+
+	table := [][]string{reportColumns}
+	for _, call := range mck.expected {
+		table = append(table, call.reportRow())
+	}
+
+	widths := make([]int, len(reportColumns))
+	for _, cells := range table {
+		for i, cell := range cells {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+
+	for i, cells := range table {
+		name := "#"
+		if i > 0 {
+			name = strconv.Itoa(i - 1)
+		}
+		line := make([]string, 0, len(cells))
+		for j, cell := range cells {
+			if j < len(cells)-1 {
+				cell += strings.Repeat(" ", widths[j]-len(cell))
+			}
+			line = append(line, cell)
+		}
+		_ = msg.Append(name, "%s", strings.Join(line, "  "))
+	}
+	return msg
+}
+
+// logReport logs the expectations report when the mock was created with the
+// [WithReport] option. It must be called with the mutex held.
+func (mck *Mock) logReport() {
+	if mck.report {
+		mck.t.Helper()
+		mck.t.Log(mck.expectationsReport())
+	}
+}
+
+// reportRow returns the expectations report table cells describing the call.
+func (c *Call) reportRow() []string {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	args := "..."
+	if !c.argsAny && !(c.proxy.IsValid() && len(c.args) == 0) {
+		args = strings.Join(flatArgs(c.args), ", ")
+	}
+
+	want := ">=1"
+	switch {
+	case c.wantCalls > 0:
+		want = strconv.Itoa(c.wantCalls)
+	case c.optional:
+		want = "any"
+	}
+
+	return []string{
+		c.Method,
+		args,
+		want,
+		strconv.Itoa(c.haveCalls),
+		yesNo(c.optional),
+		yesNo(c.satisfied(c.haveCalls) == nil),
+	}
+}
+
+// yesNo returns "yes" for true and "no" for false.
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/goldy"
+	"github.com/ctx42/testing/pkg/testcases"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_Mock_ExpectationsReport(t *testing.T) {
+	t.Run("no expectations", func(t *testing.T) {
+		// --- Given ---
+		mck := &Mock{}
+
+		// --- When ---
+		have := mck.ExpectationsReport()
+
+		// --- Then ---
+		assert.Equal(t, "[mock] expectations report", have.Error())
+	})
+
+	t.Run("expectations", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectFail()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		mck.On("Open", "a.txt").Once()
+		mck.OnAny("Read")
+		mck.On("Write", AnyString, 1).Times(2)
+		mck.On("Close").Optional()
+		mck.Proxy((&testcases.TPtr{}).AAA)
+
+		mck.Call("Open", "a.txt")
+		mck.expected[2].haveCalls = 3
+		mck.Call("AAA")
+
+		// --- When ---
+		have := mck.ExpectationsReport()
+
+		// --- Then ---
+		want := goldy.Open(t, "testdata/report.gld")
+		assert.Equal(t, want.String(), have.Error())
+	})
+}
+
+func Test_Mock_logReport(t *testing.T) {
+	t.Run("logged with option", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := goldy.Open(t, "testdata/report_logged.gld")
+		tspy.ExpectLogEqual(wMsg.String())
+		tspy.Close()
+
+		mck := NewMock(tspy, WithReport)
+		mck.On("Zero", 0)
+
+		// --- When ---
+		have := mck.AssertCallCount("Zero", 1)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("not logged without option", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogNotContain(hReport)
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		mck.On("Zero", 0)
+
+		// --- When ---
+		have := mck.AssertCallCount("Zero", 1)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("not logged by AssertExpectations", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogNotContain(hReport)
+		tspy.Close()
+
+		mck := NewMock(tspy, WithReport)
+		mck.On("Zero", 0)
+
+		// --- When ---
+		have := mck.AssertExpectations()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("logged before fatal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectFail()
+		tspy.ExpectLogContain(hReport)
+		tspy.Close()
+
+		mck := NewMock(tspy, WithReport, WithNoStack)
+
+		// --- When ---
+		assert.Panic(t, func() { mck.Call("Zero", 0) })
+
+		// --- Then ---
+		assert.True(t, mck.failed)
+	})
+}
+
+func Test_Call_reportRow_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		call *Call
+		want []string
+	}{
+		{
+			"no args",
+			newCall("Zero"),
+			[]string{"Zero", "", ">=1", "0", "no", "no"},
+		},
+		{
+			"with args",
+			newCall("Zero", 1, "a"),
+			[]string{"Zero", `1, "a"`, ">=1", "0", "no", "no"},
+		},
+		{
+			"any args",
+			&Call{cStack: cStack{Method: "Zero"}, argsAny: true, haveCalls: 1},
+			[]string{"Zero", "...", ">=1", "1", "no", "yes"},
+		},
+		{
+			"times",
+			&Call{cStack: cStack{Method: "Zero"}, wantCalls: 2, haveCalls: 2},
+			[]string{"Zero", "", "2", "2", "no", "yes"},
+		},
+		{
+			"optional",
+			newCall("Zero").Optional(),
+			[]string{"Zero", "", "any", "0", "yes", "yes"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.call.reportRow()
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// Sequence represents an ordered list of expected calls. The calls may belong
+// to the same or different [Mock] instances.
+//
+// A call in a sequence can be made only when all calls preceding it in the
+// sequence are satisfied (see [Call.Satisfied]), and no call following it has
+// been made yet. Violating the order fails the test with a message showing the
+// expected and the actual call order.
+//
+// Use [InOrder] to create a sequence from existing calls, or [NewSequence]
+// and [Call.InSequence] to register calls one by one.
+type Sequence struct {
+	// Calls in the expected order.
+	steps []*Call
+
+	// Index of the last step called.
+	pos int
+
+	// Steps in the order they were called.
+	have []*Call
+
+	// Guards the fields.
+	mx sync.Mutex
+}
+
+// NewSequence returns a new empty [Sequence]. Calls are registered in the
+// sequence with [Call.InSequence] in the order they are expected.
+func NewSequence() *Sequence { return &Sequence{} }
+
+// InOrder returns a new [Sequence] expecting the calls to be made in the given
+// order. It panics when any of the calls is nil or already belongs to a
+// sequence.
+//
+// Example:
+//
+//	mock.InOrder(
+//	    db.On("Begin").Return(tx, nil),
+//	    tx.On("Write", "a").Return(nil),
+//	    tx.On("Write", "b").Return(nil),
+//	    tx.On("Commit").Return(nil),
+//	)
+func InOrder(calls ...*Call) *Sequence {
+	seq := NewSequence()
+	for _, call := range calls {
+		if call == nil {
+			panic("a nil instance of mock.Call passed to mock.InOrder")
+		}
+		call.InSequence(seq)
+	}
+	return seq
+}
+
+// add adds the call at the end of the sequence.
+func (seq *Sequence) add(call *Call) {
+	seq.mx.Lock()
+	defer seq.mx.Unlock()
+	seq.steps = append(seq.steps, call)
+}
+
+// next records the call as the next call in the sequence. Returns an error
+// wrapping [ErrOrder] when the call is made out of order, in which case the
+// call is not recorded. The stack parameter should contain the stack trace
+// from where the method was invoked.
+func (seq *Sequence) next(call *Call, cs []string) error {
+	seq.mx.Lock()
+	defer seq.mx.Unlock()
+
+	idx, err := seq.check(call, cs)
+	if err != nil {
+		return err
+	}
+	seq.pos = idx
+	seq.have = append(seq.have, call)
+	return nil
+}
+
+// inOrder returns nil when the call can be made now without breaking the
+// sequence order, otherwise it returns an error wrapping [ErrOrder]. Unlike
+// [Sequence.next] it doesn't record the call.
+func (seq *Sequence) inOrder(call *Call, cs []string) error {
+	seq.mx.Lock()
+	defer seq.mx.Unlock()
+	_, err := seq.check(call, cs)
+	return err
+}
+
+// check returns the index of the call in the sequence when it can be made now
+// without breaking the sequence order, otherwise it returns an error wrapping
+// [ErrOrder]. It must be called with the mutex held.
+func (seq *Sequence) check(call *Call, cs []string) (int, error) {
+	idx := -1
+	for i, step := range seq.steps {
+		if step == call {
+			idx = i
+			break
+		}
+	}
+
+	if idx < seq.pos {
+		// One of the following calls has already been made.
+		return 0, seq.notice(call, seq.steps[seq.pos], "called after", cs)
+	}
+	for _, step := range seq.steps[seq.pos:idx] {
+		if !step.Satisfied() {
+			return 0, seq.notice(call, step, "requires", cs)
+		}
+	}
+	return idx, nil
+}
+
+// notice returns the error describing out of order call. The "other" call is
+// described with the "rel" header.
+func (seq *Sequence) notice(call, other *Call, rel string, cs []string) error {
+	want := make([]string, 0, len(seq.steps))
+	for i, step := range seq.steps {
+		want = append(want, fmt.Sprintf("%d: %s", i, step.format()))
+	}
+	have := make([]string, 0, len(seq.have)+1)
+	for i, step := range seq.have {
+		have = append(have, fmt.Sprintf("%d: %s", i, step.format()))
+	}
+	have = append(have, fmt.Sprintf("%d: %s", len(seq.have), call.format()))
+
+	msg := notice.New(hOutOfOrder).
+		Append("method", "%s", call.format()).
+		Append(rel, "%s", other.format()).
+		Append("want order", "\n%s", strings.Join(want, "\n")).
+		Append("have order", "\n%s", strings.Join(have, "\n")).
+		Wrap(ErrOrder)
+	if len(cs) > 0 {
+		_ = msg.Append("stack", "\n%s", strings.Join(cs, "\n"))
+	}
+	return msg
+}
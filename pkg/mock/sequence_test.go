@@ -0,0 +1,275 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/goldy"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_NewSequence(t *testing.T) {
+	// --- When ---
+	have := NewSequence()
+
+	// --- Then ---
+	assert.Nil(t, have.steps)
+	assert.Equal(t, 0, have.pos)
+	assert.Nil(t, have.have)
+}
+
+func Test_InOrder(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		call0 := newCall("Zero")
+		call1 := newCall("One")
+
+		// --- When ---
+		have := InOrder(call0, call1)
+
+		// --- Then ---
+		assert.Equal(t, []*Call{call0, call1}, have.steps)
+		assert.Same(t, have, call0.seq)
+		assert.Same(t, have, call1.seq)
+	})
+
+	t.Run("panics when nil is one of the arguments", func(t *testing.T) {
+		// --- Then ---
+		msg := assert.PanicMsg(t, func() { InOrder(newCall("Zero"), nil) })
+		assert.Contain(t, "nil instance", *msg)
+	})
+
+	t.Run("panics when call already in a sequence", func(t *testing.T) {
+		// --- Given ---
+		call := newCall("Zero")
+		InOrder(call)
+
+		// --- Then ---
+		msg := assert.PanicMsg(t, func() { InOrder(call) })
+		assert.Equal(t, "mock.Call already belongs to a sequence", *msg)
+	})
+}
+
+func Test_Sequence_next(t *testing.T) {
+	t.Run("first call", func(t *testing.T) {
+		// --- Given ---
+		call0 := newCall("Zero")
+		seq := InOrder(call0, newCall("One"))
+
+		// --- When ---
+		err := seq.next(call0, nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 0, seq.pos)
+		assert.Equal(t, []*Call{call0}, seq.have)
+	})
+
+	t.Run("the same call many times", func(t *testing.T) {
+		// --- Given ---
+		call0 := newCall("Zero")
+		seq := InOrder(call0, newCall("One"))
+		assert.NoError(t, seq.next(call0, nil))
+		call0.haveCalls++
+
+		// --- When ---
+		err := seq.next(call0, nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 0, seq.pos)
+		assert.Equal(t, []*Call{call0, call0}, seq.have)
+	})
+
+	t.Run("previous calls satisfied", func(t *testing.T) {
+		// --- Given ---
+		call0 := newCall("Zero")
+		call1 := newCall("One").Optional()
+		call2 := newCall("Two")
+		seq := InOrder(call0, call1, call2)
+		assert.NoError(t, seq.next(call0, nil))
+		call0.haveCalls++
+
+		// --- When ---
+		err := seq.next(call2, nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 2, seq.pos)
+		assert.Equal(t, []*Call{call0, call2}, seq.have)
+	})
+
+	t.Run("error - previous call not satisfied", func(t *testing.T) {
+		// --- Given ---
+		call0 := newCall("Begin")
+		call1 := newCall("Write", "a").Times(2)
+		call2 := newCall("Commit")
+		seq := InOrder(call0, call1, call2)
+		assert.NoError(t, seq.next(call0, nil))
+		call0.haveCalls++
+		assert.NoError(t, seq.next(call1, nil))
+		call1.haveCalls++
+
+		// --- When ---
+		err := seq.next(call2, []string{"line0", "line1"})
+
+		// --- Then ---
+		want := goldy.Open(t, "testdata/sequence_requires.gld")
+		assert.ErrorEqual(t, want.String(), err)
+		assert.ErrorIs(t, ErrOrder, err)
+		assert.Equal(t, 1, seq.pos)
+		assert.Equal(t, []*Call{call0, call1}, seq.have)
+	})
+
+	t.Run("error - called after following call", func(t *testing.T) {
+		// --- Given ---
+		call0 := newCall("Begin")
+		call1 := newCall("Write", "a").Optional()
+		call2 := newCall("Commit")
+		seq := InOrder(call0, call1, call2)
+		assert.NoError(t, seq.next(call0, nil))
+		call0.haveCalls++
+		assert.NoError(t, seq.next(call2, nil))
+		call2.haveCalls++
+
+		// --- When ---
+		err := seq.next(call1, nil)
+
+		// --- Then ---
+		want := goldy.Open(t, "testdata/sequence_called_after.gld")
+		assert.ErrorEqual(t, want.String(), err)
+		assert.ErrorIs(t, ErrOrder, err)
+		assert.Equal(t, 2, seq.pos)
+		assert.Equal(t, []*Call{call0, call2}, seq.have)
+	})
+}
+
+func Test_Sequence_inOrder(t *testing.T) {
+	t.Run("in order", func(t *testing.T) {
+		// --- Given ---
+		call0 := newCall("Zero")
+		seq := InOrder(call0, newCall("One"))
+
+		// --- When ---
+		err := seq.inOrder(call0, nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 0, seq.pos)
+		assert.Nil(t, seq.have)
+	})
+
+	t.Run("error - out of order", func(t *testing.T) {
+		// --- Given ---
+		call1 := newCall("One")
+		seq := InOrder(newCall("Zero"), call1)
+
+		// --- When ---
+		err := seq.inOrder(call1, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOrder, err)
+		assert.Equal(t, 0, seq.pos)
+		assert.Nil(t, seq.have)
+	})
+}
+
+func Test_Sequence_Mock_integration(t *testing.T) {
+	t.Run("in order across mocks", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(2)
+		tspy.Close()
+
+		db := NewMock(tspy)
+		tx := NewMock(tspy)
+		InOrder(
+			db.On("Begin").Return(tx),
+			tx.On("Write", "a").Return(nil),
+			tx.On("Write", "b").Return(nil),
+			tx.On("Commit").Return(nil),
+		)
+
+		// --- When ---
+		db.Call("Begin")
+		tx.Call("Write", "a")
+		tx.Call("Write", "b")
+		tx.Call("Commit")
+
+		// --- Then ---
+		assert.False(t, db.Failed())
+		assert.False(t, tx.Failed())
+	})
+
+	t.Run("the same method many times", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		InOrder(
+			mck.On("Begin"),
+			mck.On("Write", Any).Times(2),
+			mck.On("Commit"),
+		)
+
+		// --- When ---
+		mck.Call("Begin")
+		mck.Call("Write", "a")
+		mck.Call("Write", "b")
+		mck.Call("Commit")
+
+		// --- Then ---
+		assert.False(t, mck.Failed())
+	})
+
+	t.Run("overlapping expectations", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mck := NewMock(tspy)
+		last := mck.On("Write", "b").Once().Return(2)
+		first := mck.On("Write", Any).Once().Return(1)
+		InOrder(first, last)
+
+		// --- When ---
+		have0 := mck.Call("Write", "b")
+		have1 := mck.Call("Write", "b")
+
+		// --- Then ---
+		assert.Equal(t, Arguments{1}, have0)
+		assert.Equal(t, Arguments{2}, have1)
+		assert.False(t, mck.Failed())
+	})
+
+	t.Run("error - out of order across mocks", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(2)
+		tspy.ExpectFail()
+		wMsg := goldy.Open(t, "testdata/sequence_out_of_order.gld")
+		tspy.ExpectLogEqual(wMsg.String())
+		tspy.Close()
+
+		db := NewMock(tspy, WithNoStack)
+		tx := NewMock(tspy, WithNoStack)
+		InOrder(
+			db.On("Begin").Return(tx),
+			tx.On("Write", "a").Return(nil),
+			tx.On("Commit").Return(nil),
+		)
+		db.Call("Begin")
+
+		// --- When ---
+		assert.Panic(t, func() { tx.Call("Commit") })
+
+		// --- Then ---
+		assert.True(t, tx.failed)
+	})
+}
@@ -60,6 +60,12 @@ func WithTesterAlias(alias string) Option {
 	return func(cfg *Config) { cfg.testerAlias = alias }
 }
 
+// withResolver sets the package cache used to resolve the source and target
+// packages.
+func withResolver(res *resolver) Option {
+	return func(cfg *Config) { cfg.res = res }
+}
+
 // Config holds the configuration for generating a mock.
 // It is usually created internally via options rather than directly by users.
 type Config struct {
@@ -70,6 +76,14 @@ type Config struct {
 
 	srcItf *ast.InterfaceType // Interface to mock.
 
+	// Interface type parameters mapped to the types they stand for. For the
+	// interface to mock, the type parameters stand for themselves, for the
+	// embedded generic interfaces, for the type arguments.
+	srcTParams map[string]expression
+
+	// Type arguments the embedded generic interface is instantiated with.
+	srcTArgs []expression
+
 	tgtName     string    // Custom name for the mock type.
 	tgtDirOrImp string    // Target directory or import path.
 	tgtFilename string    // Custom filename for the mock.
@@ -78,6 +92,8 @@ type Config struct {
 
 	onHelpers   bool   // Generate "OnXXX" helper methods.
 	testerAlias string // Alias for the CTX42 tester package.
+
+	res *resolver // Package cache.
 }
 
 // newConfig creates a validated configuration for mocking the given
@@ -99,18 +115,21 @@ func newConfig(name string, opts ...Option) (Config, error) {
 	for _, opt := range opts {
 		opt(&cfg)
 	}
+	if cfg.res == nil {
+		cfg.res = &resolver{}
+	}
 
 	var srcWd string
 	srcWd, cfg.srcDirOrImp = detectDirOrImp(wd, cfg.srcDirOrImp)
 	cfg.srcPkg = newPkg(srcWd, cfg.srcDirOrImp)
-	if err = cfg.srcPkg.resolve(); err != nil {
+	if err = cfg.res.resolve(cfg.srcPkg); err != nil {
 		return Config{}, err
 	}
 
 	var tgtWd string
 	tgtWd, cfg.tgtDirOrImp = detectDirOrImp(wd, cfg.tgtDirOrImp)
 	cfg.tgtPkg = newPkg(tgtWd, cfg.tgtDirOrImp)
-	if err = cfg.tgtPkg.resolve(); err != nil {
+	if err = cfg.res.resolve(cfg.tgtPkg); err != nil {
 		return Config{}, err
 	}
 
@@ -39,9 +39,12 @@ func ExampleGenerate() {
 	//	t tester.T
 	// }
 	//
-	// func NewCase00Mock(t tester.T) *Case00Mock {
+	// func NewCase00Mock(t tester.T, opts ...mock.Option) *Case00Mock {
 	//	t.Helper()
-	//	return &Case00Mock{Mock: mock.NewMock(t), t: t}
+	//	_mck := &Case00Mock{t: t}
+	//	opts = append([]mock.Option{mock.WithOwner(_mck)}, opts...)
+	//	_mck.Mock = mock.NewMock(t, opts...)
+	//	return _mck
 	// }
 	//
 	// func (_mck *Case00Mock) Method00() {
@@ -80,9 +83,12 @@ func ExampleGenerate_usingImportPaths() {
 	//	t tester.T
 	// }
 	//
-	// func NewCase00Mock(t tester.T) *Case00Mock {
+	// func NewCase00Mock(t tester.T, opts ...mock.Option) *Case00Mock {
 	//	t.Helper()
-	//	return &Case00Mock{Mock: mock.NewMock(t), t: t}
+	//	_mck := &Case00Mock{t: t}
+	//	opts = append([]mock.Option{mock.WithOwner(_mck)}, opts...)
+	//	_mck.Mock = mock.NewMock(t, opts...)
+	//	return _mck
 	// }
 	//
 	// func (_mck *Case00Mock) Method00() {
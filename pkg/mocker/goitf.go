@@ -13,8 +13,9 @@ const (
 
 // goitf represents an interface.
 type goitf struct {
-	name    string    // The interface name.
-	methods []*method // The interface methods.
+	name    string     // The interface name.
+	tparams []argument // The interface type parameters with constraints.
+	methods []*method  // The interface methods.
 }
 
 // find returns the interface method by the name, or [ErrUnkMet] if not found.
@@ -43,10 +44,52 @@ func (itf *goitf) generate(recType string, onHelpers bool) string {
 	return code.String()
 }
 
+// genTypeParams generates code for the interface type parameter list as used
+// in type and function declarations. Returns an empty string if the interface
+// is not generic.
+//
+// Examples:
+//
+//	[T any]
+//	[K comparable, V any]
+//	[T ~int | ~string]
+func (itf *goitf) genTypeParams() string {
+	if len(itf.tparams) == 0 {
+		return ""
+	}
+	params := make([]string, 0, len(itf.tparams))
+	for _, tp := range itf.tparams {
+		params = append(params, tp.name+" "+tp.typ)
+	}
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+// genTypeArgs generates code for the interface type parameter names as used
+// when instantiating the generic type. Returns an empty string if the
+// interface is not generic.
+//
+// Examples:
+//
+//	[T]
+//	[K, V]
+func (itf *goitf) genTypeArgs() string {
+	if len(itf.tparams) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(itf.tparams))
+	for _, tp := range itf.tparams {
+		names = append(names, tp.name)
+	}
+	return "[" + strings.Join(names, ", ") + "]"
+}
+
 // imports returns unique imports used by all the interface methods in
-// arguments and return values.
+// arguments and return values, and by the type parameter constraints.
 func (itf *goitf) imports() []*gopkg {
 	var imps []*gopkg
+	for _, tp := range itf.tparams {
+		imps = addUniquePackage(imps, tp.pks...)
+	}
 	for _, met := range itf.methods {
 		imps = addUniquePackage(imps, met.imports()...)
 	}
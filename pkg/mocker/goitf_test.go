@@ -129,6 +129,86 @@ func Test_goitf_generate(t *testing.T) {
 	})
 }
 
+func Test_goitf_genTypeParams(t *testing.T) {
+	t.Run("not generic", func(t *testing.T) {
+		// --- Given ---
+		itf := &goitf{}
+
+		// --- When ---
+		have := itf.genTypeParams()
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("single type parameter", func(t *testing.T) {
+		// --- Given ---
+		itf := &goitf{tparams: []argument{{name: "T", typ: "any"}}}
+
+		// --- When ---
+		have := itf.genTypeParams()
+
+		// --- Then ---
+		assert.Equal(t, "[T any]", have)
+	})
+
+	t.Run("multiple type parameters", func(t *testing.T) {
+		// --- Given ---
+		itf := &goitf{
+			tparams: []argument{
+				{name: "K", typ: "comparable"},
+				{name: "V", typ: "~int | ~string"},
+			},
+		}
+
+		// --- When ---
+		have := itf.genTypeParams()
+
+		// --- Then ---
+		assert.Equal(t, "[K comparable, V ~int | ~string]", have)
+	})
+}
+
+func Test_goitf_genTypeArgs(t *testing.T) {
+	t.Run("not generic", func(t *testing.T) {
+		// --- Given ---
+		itf := &goitf{}
+
+		// --- When ---
+		have := itf.genTypeArgs()
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("single type parameter", func(t *testing.T) {
+		// --- Given ---
+		itf := &goitf{tparams: []argument{{name: "T", typ: "any"}}}
+
+		// --- When ---
+		have := itf.genTypeArgs()
+
+		// --- Then ---
+		assert.Equal(t, "[T]", have)
+	})
+
+	t.Run("multiple type parameters", func(t *testing.T) {
+		// --- Given ---
+		itf := &goitf{
+			tparams: []argument{
+				{name: "K", typ: "comparable"},
+				{name: "V", typ: "any"},
+			},
+		}
+
+		// --- When ---
+		have := itf.genTypeArgs()
+
+		// --- Then ---
+		assert.Equal(t, "[K, V]", have)
+	})
+}
+
 func Test_goitf_imports(t *testing.T) {
 	t.Run("no methods", func(t *testing.T) {
 		// --- Given ---
@@ -227,4 +307,41 @@ func Test_goitf_imports(t *testing.T) {
 		}
 		assert.Equal(t, want, have)
 	})
+
+	t.Run("type parameter constraints", func(t *testing.T) {
+		// --- Given ---
+		itf := goitf{
+			tparams: []argument{
+				{
+					name: "T",
+					pks: []*gopkg{
+						{pkgName: "fmt", pkgPath: "fmt"},
+					},
+				},
+			},
+			methods: []*method{
+				{
+					name: "Method0",
+					args: []argument{
+						{
+							name: "a",
+							pks: []*gopkg{
+								{pkgName: "a0", pkgPath: "a0_path"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		// --- When ---
+		have := itf.imports()
+
+		// --- Then ---
+		want := []*gopkg{
+			{pkgName: "fmt", pkgPath: "fmt"},
+			{pkgName: "a0", pkgPath: "a0_path"},
+		}
+		assert.Equal(t, want, have)
+	})
 }
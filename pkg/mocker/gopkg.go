@@ -251,15 +251,16 @@ func (pkg *gopkg) findType(name string) (*file, *ast.TypeSpec, error) {
 }
 
 // findItf locates an interface type declaration named `name` in the package.
-// It returns the containing file, the interface's AST node, and nil error if
-// the named type is an interface.
-func (pkg *gopkg) findItf(name string) (*file, *ast.InterfaceType, error) {
+// It returns the containing file, the type's AST node, and nil error if the
+// named type is an interface. The type's AST node gives access to the
+// interface type parameters, if any.
+func (pkg *gopkg) findItf(name string) (*file, *ast.TypeSpec, error) {
 	fil, typ, err := pkg.findType(name)
 	if err != nil {
 		return nil, nil, err
 	}
-	if itf, ok := typ.Type.(*ast.InterfaceType); ok {
-		return fil, itf, nil
+	if _, ok := typ.Type.(*ast.InterfaceType); ok {
+		return fil, typ, nil
 	}
 	return nil, nil, fmt.Errorf("%w: %s is not an interface", ErrUnkItf, name)
 }
@@ -292,6 +293,14 @@ func (pkg *gopkg) equal(other *gopkg) bool {
 	if pkg.pkgDir != "" && pkg.pkgDir == other.pkgDir {
 		return true
 	}
+	// Not resolved packages given by directory have only the working
+	// directory set (see newPkg).
+	if other.pkgPath == "" && other.pkgDir == "" && other.wd != "" {
+		return pkg.pkgDir == other.wd
+	}
+	if pkg.pkgPath == "" && pkg.pkgDir == "" && pkg.wd != "" {
+		return pkg.wd == other.pkgDir
+	}
 	return false
 }
 
@@ -4,6 +4,7 @@
 package mocker
 
 import (
+	"go/ast"
 	"go/scanner"
 	"go/token"
 	"os"
@@ -607,7 +608,24 @@ func Test_gopkg_findItf(t *testing.T) {
 		// --- Then ---
 		assert.NoError(t, err)
 		assert.Equal(t, filepath.Join(dir, "cases.go"), hFil.path)
-		assert.NotNil(t, hItf)
+		assert.Equal(t, "Case00", hItf.Name.Name)
+		assert.SameType(t, &ast.InterfaceType{}, hItf.Type)
+	})
+
+	t.Run("generic interface", func(t *testing.T) {
+		// --- Given ---
+		dir := filepath.Join(must.Value(os.Getwd()), "testdata/cases")
+		pkg := &gopkg{pkgDir: dir}
+
+		// --- When ---
+		hFil, hItf, err := pkg.findItf("Case63")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "cases.go"), hFil.path)
+		assert.Equal(t, "Case63", hItf.Name.Name)
+		assert.Len(t, 2, hItf.TypeParams.List)
+		assert.SameType(t, &ast.InterfaceType{}, hItf.Type)
 	})
 
 	t.Run("error - type alias from the same package", func(t *testing.T) {
@@ -783,6 +801,56 @@ func Test_gopkg_equal_tabular(t *testing.T) {
 	}
 }
 
+func Test_gopkg_equal(t *testing.T) {
+	t.Run("not resolved directory package", func(t *testing.T) {
+		// --- Given ---
+		pkg := newPkg("/dir", "")
+		other := &gopkg{pkgPath: "example.com/mod", pkgDir: "/dir"}
+
+		// --- When ---
+		have := pkg.equal(other)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("resolved package equal to not resolved directory package", func(t *testing.T) {
+		// --- Given ---
+		pkg := &gopkg{pkgPath: "example.com/mod", pkgDir: "/dir"}
+		other := newPkg("/dir", "")
+
+		// --- When ---
+		have := pkg.equal(other)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not resolved directory package not equal", func(t *testing.T) {
+		// --- Given ---
+		pkg := newPkg("/dir", "")
+		other := &gopkg{pkgPath: "example.com/mod", pkgDir: "/other"}
+
+		// --- When ---
+		have := pkg.equal(other)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("not resolved import path package", func(t *testing.T) {
+		// --- Given ---
+		pkg := newPkg("/dir", "example.com/other")
+		other := &gopkg{pkgPath: "example.com/mod", pkgDir: "/dir"}
+
+		// --- When ---
+		have := pkg.equal(other)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
 func Test_gopkg_from(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// --- Given ---
@@ -225,6 +225,7 @@ var builtin = []string{
 	"any",
 	"bool",
 	"byte",
+	"comparable",
 	"error",
 	"rune",
 	"string",
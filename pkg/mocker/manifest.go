@@ -0,0 +1,282 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package mocker
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ctx42/testing/internal/yaml"
+)
+
+// Manifest describes mocks to generate in one run with
+// [Mocker.GenerateManifest]. It is usually read from a YAML or JSON file with
+// [ReadManifest].
+//
+// Example YAML manifest:
+//
+//	mocks:
+//	  - src: github.com/acme/app/store
+//	    tgt: ./mocks
+//	    interfaces: [Store, Cache]
+//	  - src: io
+//	    tgt: ./mocks
+//	    interfaces: [Reader]
+//	    name: ReaderMock
+//	    helpers: true
+type Manifest struct {
+	Mocks []ManifestEntry `json:"mocks"`
+}
+
+// ManifestEntry describes mocks for interfaces defined in one source package.
+// The fields correspond to the [Option] functions.
+type ManifestEntry struct {
+	// Source directory or import path (see [WithSrc]).
+	Src string `json:"src"`
+
+	// Target directory or import path (see [WithTgt]).
+	Tgt string `json:"tgt"`
+
+	// Names of the interfaces to mock.
+	Interfaces []string `json:"interfaces"`
+
+	// Mock type name (see [WithTgtName]). Single interface entries only.
+	Name string `json:"name"`
+
+	// Mock filename (see [WithTgtFilename]). Single interface entries only.
+	Filename string `json:"filename"`
+
+	// Generate "OnXXX" helper methods (see [WithTgtOnHelpers]).
+	Helpers bool `json:"helpers"`
+
+	// Alias for the tester package import (see [WithTesterAlias]).
+	TesterAlias *string `json:"tester_alias"`
+}
+
+// options returns the configuration options for the entry.
+func (ent ManifestEntry) options() []Option {
+	opts := []Option{WithSrc(ent.Src), WithTgt(ent.Tgt)}
+	if ent.Name != "" {
+		opts = append(opts, WithTgtName(ent.Name))
+	}
+	if ent.Filename != "" {
+		opts = append(opts, WithTgtFilename(ent.Filename))
+	}
+	if ent.Helpers {
+		opts = append(opts, WithTgtOnHelpers)
+	}
+	if ent.TesterAlias != nil {
+		opts = append(opts, WithTesterAlias(*ent.TesterAlias))
+	}
+	return opts
+}
+
+// ReadManifest reads the manifest from a YAML (".yaml", ".yml") or JSON
+// (".json") file. Relative source and target directories are relative to the
+// manifest file directory.
+func ReadManifest(pth string) (Manifest, error) {
+	// G304: path comes from trusted mocker configuration.
+	data, err := os.ReadFile(pth) // nolint:gosec
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(pth)); ext {
+	case ".json":
+	case ".yaml", ".yml":
+		var doc any
+		if doc, err = yaml.Parse(data); err != nil {
+			return Manifest{}, fmt.Errorf("%w: %s: %w", ErrManifest, pth, err)
+		}
+		if data, err = json.Marshal(doc); err != nil {
+			return Manifest{}, fmt.Errorf("%w: %s: %w", ErrManifest, pth, err)
+		}
+	default:
+		format := "%w: %s: unsupported file extension %q"
+		return Manifest{}, fmt.Errorf(format, ErrManifest, pth, ext)
+	}
+
+	var man Manifest
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&man); err != nil {
+		return Manifest{}, fmt.Errorf("%w: %s: %w", ErrManifest, pth, err)
+	}
+	if err = man.validate(); err != nil {
+		return Manifest{}, err
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(pth))
+	if err != nil {
+		return Manifest{}, err
+	}
+	for i := range man.Mocks {
+		man.Mocks[i].Src = relativeTo(dir, man.Mocks[i].Src)
+		man.Mocks[i].Tgt = relativeTo(dir, man.Mocks[i].Tgt)
+	}
+	return man, nil
+}
+
+// relativeTo returns an absolute path for the "dirOrImp" if it is a directory
+// relative to "dir". Otherwise, returns it unchanged.
+func relativeTo(dir, dirOrImp string) string {
+	if dirOrImp == "" {
+		return ""
+	}
+	if pth, imp := detectDirOrImp(dir, dirOrImp); imp == "" {
+		return pth
+	}
+	return dirOrImp
+}
+
+// validate validates the manifest.
+func (man Manifest) validate() error {
+	if len(man.Mocks) == 0 {
+		return fmt.Errorf("%w: no mocks", ErrManifest)
+	}
+	for i, ent := range man.Mocks {
+		if len(ent.Interfaces) == 0 {
+			return fmt.Errorf("%w: mocks[%d]: no interfaces", ErrManifest, i)
+		}
+		if len(ent.Interfaces) > 1 && (ent.Name != "" || ent.Filename != "") {
+			format := "%w: mocks[%d]: name and filename require a single interface"
+			return fmt.Errorf(format, ErrManifest, i)
+		}
+	}
+	return nil
+}
+
+// Summary describes the result of generating or checking a mock for one
+// interface with [Mocker.GenerateManifest] or [Mocker.CheckManifest].
+type Summary struct {
+	Interface string // Interface name.
+	Src       string // Source directory or import path.
+	Mock      string // Mock type name.
+	Filename  string // Path to the mock file.
+	Written   bool   // Mock file has been written.
+	Checked   bool   // Mock file has been checked and is up to date.
+	Err       error  // Mock generation or check error.
+}
+
+// String returns a single line summary. Only the first line of a multi-line
+// error (like the [ErrStale] error with a diff) is included.
+//
+// Examples:
+//
+//	ok   Store: StoreMock -> /app/mocks/store_mock.go
+//	skip Cache: CacheMock -> /app/mocks/cache_mock.go (not written)
+//	FAIL Reader: interface not found: Reader is not an interface
+func (sum Summary) String() string {
+	if sum.Err != nil {
+		msg, _, _ := strings.Cut(sum.Err.Error(), "\n")
+		return fmt.Sprintf("FAIL %s: %s", sum.Interface, msg)
+	}
+	const format = "%s %s: %s -> %s"
+	if !sum.Written && !sum.Checked {
+		format := format + " (not written)"
+		return fmt.Sprintf(format, "skip", sum.Interface, sum.Mock, sum.Filename)
+	}
+	return fmt.Sprintf(format, "ok  ", sum.Interface, sum.Mock, sum.Filename)
+}
+
+// GenerateManifest generates mocks for all interfaces listed in the manifest,
+// reusing the [Mocker] package cache. Returns a summary for each interface in
+// the order they are listed in the manifest.
+//
+// The generation is atomic: mocks are generated in memory and written to
+// their target files only when all of them were generated successfully.
+// Otherwise, none of the target files is created or modified, and the
+// returned error joins errors for all failed interfaces.
+func (mck *Mocker) GenerateManifest(man Manifest) ([]Summary, error) {
+	if err := man.validate(); err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	var sums []Summary
+	var outs []output
+	seen := make(map[string]bool)
+	for _, ent := range man.Mocks {
+		for _, name := range ent.Interfaces {
+			sum := Summary{Interface: name, Src: ent.Src}
+			out, err := mck.generateEntry(ent, name, &sum)
+			if err == nil && seen[out.pth] {
+				err = fmt.Errorf("%w: duplicate mock file %s", ErrManifest, out.pth)
+			}
+			if err != nil {
+				sum.Err = err
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			}
+			seen[out.pth] = true
+			sums = append(sums, sum)
+			outs = append(outs, out)
+		}
+	}
+	if len(errs) > 0 {
+		return sums, errors.Join(errs...)
+	}
+
+	if err := writeOutputs(outs); err != nil {
+		return sums, err
+	}
+	for i := range sums {
+		sums[i].Written = true
+	}
+	return sums, nil
+}
+
+// generateEntry generates in memory the mock for the named interface from the
+// manifest entry, filling the summary.
+func (mck *Mocker) generateEntry(
+	ent ManifestEntry,
+	name string,
+	sum *Summary,
+) (output, error) {
+
+	cfg, err := mck.config(name, ent.options()...)
+	if err != nil {
+		return output{}, err
+	}
+	sum.Mock = cfg.tgtName
+	sum.Filename = cfg.tgtFilename
+	return mck.generateOutput(cfg)
+}
+
+// CheckManifest generates in memory mocks for all interfaces listed in the
+// manifest and compares them with the mock files on disk. Nothing is written.
+// Returns a summary for each interface in the order they are listed in the
+// manifest.
+//
+// The returned error joins errors for all interfaces which mocks could not be
+// generated or are out of date (see [ErrStale]).
+func (mck *Mocker) CheckManifest(man Manifest) ([]Summary, error) {
+	if err := man.validate(); err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	var sums []Summary
+	for _, ent := range man.Mocks {
+		for _, name := range ent.Interfaces {
+			sum := Summary{Interface: name, Src: ent.Src}
+			out, err := mck.generateEntry(ent, name, &sum)
+			if err == nil {
+				err = out.check()
+			}
+			if err != nil {
+				sum.Err = err
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			} else {
+				sum.Checked = true
+			}
+			sums = append(sums, sum)
+		}
+	}
+	return sums, errors.Join(errs...)
+}
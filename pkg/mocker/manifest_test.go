@@ -0,0 +1,499 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package mocker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/internal/tstmod"
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_ManifestEntry_options(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// --- Given ---
+		ent := ManifestEntry{Src: "src", Tgt: "tgt"}
+		cfg := &Config{}
+
+		// --- When ---
+		for _, opt := range ent.options() {
+			opt(cfg)
+		}
+
+		// --- Then ---
+		want := &Config{srcDirOrImp: "src", tgtDirOrImp: "tgt"}
+		assert.Equal(t, want, cfg)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		// --- Given ---
+		alias := "tst"
+		ent := ManifestEntry{
+			Src:         "src",
+			Tgt:         "tgt",
+			Name:        "MyMock",
+			Filename:    "my.go",
+			Helpers:     true,
+			TesterAlias: &alias,
+		}
+		cfg := &Config{}
+
+		// --- When ---
+		for _, opt := range ent.options() {
+			opt(cfg)
+		}
+
+		// --- Then ---
+		want := &Config{
+			srcDirOrImp: "src",
+			tgtDirOrImp: "tgt",
+			tgtName:     "MyMock",
+			tgtFilename: "my.go",
+			onHelpers:   true,
+			testerAlias: "tst",
+		}
+		assert.Equal(t, want, cfg)
+	})
+}
+
+func Test_ReadManifest(t *testing.T) {
+	wd := must.Value(os.Getwd())
+	alias := "tst"
+	want := Manifest{
+		Mocks: []ManifestEntry{
+			{
+				Src:        filepath.Join(wd, "testdata/cases"),
+				Tgt:        "github.com/ctx42/testing/pkg/mocker/testdata/pkga",
+				Interfaces: []string{"Case00", "Case01"},
+			},
+			{
+				Src:         "github.com/ctx42/testing/pkg/mocker/testdata/cases",
+				Interfaces:  []string{"Case02"},
+				Name:        "MyMock",
+				Filename:    "my_mock.go",
+				Helpers:     true,
+				TesterAlias: &alias,
+			},
+		},
+	}
+
+	t.Run("yaml", func(t *testing.T) {
+		// --- When ---
+		have, err := ReadManifest("testdata/manifest/valid.yaml")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		// --- When ---
+		have, err := ReadManifest("testdata/manifest/valid.json")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("error - file does not exist", func(t *testing.T) {
+		// --- When ---
+		have, err := ReadManifest("testdata/manifest/not-existing.yaml")
+
+		// --- Then ---
+		assert.ErrorIs(t, os.ErrNotExist, err)
+		assert.Zero(t, have)
+	})
+
+	t.Run("error - unsupported extension", func(t *testing.T) {
+		// --- When ---
+		have, err := ReadManifest("testdata/manifest/manifest.txt")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrManifest, err)
+		assert.ErrorContain(t, `unsupported file extension ".txt"`, err)
+		assert.Zero(t, have)
+	})
+
+	t.Run("error - invalid yaml", func(t *testing.T) {
+		// --- When ---
+		have, err := ReadManifest("testdata/manifest/invalid.yaml")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrManifest, err)
+		assert.ErrorContain(t, "unterminated flow collection", err)
+		assert.Zero(t, have)
+	})
+
+	t.Run("error - unknown field", func(t *testing.T) {
+		// --- When ---
+		have, err := ReadManifest("testdata/manifest/unknown_field.yaml")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrManifest, err)
+		assert.ErrorContain(t, `unknown field "unknown"`, err)
+		assert.Zero(t, have)
+	})
+
+	t.Run("error - no mocks", func(t *testing.T) {
+		// --- When ---
+		have, err := ReadManifest("testdata/manifest/no_mocks.yaml")
+
+		// --- Then ---
+		assert.ErrorEqual(t, "invalid manifest: no mocks", err)
+		assert.Zero(t, have)
+	})
+
+	t.Run("error - no interfaces", func(t *testing.T) {
+		// --- When ---
+		have, err := ReadManifest("testdata/manifest/no_interfaces.yaml")
+
+		// --- Then ---
+		assert.ErrorEqual(t, "invalid manifest: mocks[0]: no interfaces", err)
+		assert.Zero(t, have)
+	})
+
+	t.Run("error - name with multiple interfaces", func(t *testing.T) {
+		// --- When ---
+		have, err := ReadManifest("testdata/manifest/name_multiple.yaml")
+
+		// --- Then ---
+		wMsg := "invalid manifest: mocks[0]: " +
+			"name and filename require a single interface"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Zero(t, have)
+	})
+}
+
+func Test_Summary_String(t *testing.T) {
+	t.Run("written", func(t *testing.T) {
+		// --- Given ---
+		sum := Summary{
+			Interface: "Case00",
+			Mock:      "Case00Mock",
+			Filename:  "/dir/case00_mock.go",
+			Written:   true,
+		}
+
+		// --- When ---
+		have := sum.String()
+
+		// --- Then ---
+		assert.Equal(t, "ok   Case00: Case00Mock -> /dir/case00_mock.go", have)
+	})
+
+	t.Run("not written", func(t *testing.T) {
+		// --- Given ---
+		sum := Summary{
+			Interface: "Case00",
+			Mock:      "Case00Mock",
+			Filename:  "/dir/case00_mock.go",
+		}
+
+		// --- When ---
+		have := sum.String()
+
+		// --- Then ---
+		want := "skip Case00: Case00Mock -> /dir/case00_mock.go (not written)"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("checked", func(t *testing.T) {
+		// --- Given ---
+		sum := Summary{
+			Interface: "Case00",
+			Mock:      "Case00Mock",
+			Filename:  "/dir/case00_mock.go",
+			Checked:   true,
+		}
+
+		// --- When ---
+		have := sum.String()
+
+		// --- Then ---
+		assert.Equal(t, "ok   Case00: Case00Mock -> /dir/case00_mock.go", have)
+	})
+
+	t.Run("multi-line error", func(t *testing.T) {
+		// --- Given ---
+		err := fmt.Errorf("%w: /dir/case00_mock.go\n--- diff", ErrStale)
+		sum := Summary{Interface: "Case00", Err: err}
+
+		// --- When ---
+		have := sum.String()
+
+		// --- Then ---
+		want := "FAIL Case00: mock is out of date: /dir/case00_mock.go"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		sum := Summary{Interface: "Case00", Err: ErrNoMethods}
+
+		// --- When ---
+		have := sum.String()
+
+		// --- Then ---
+		assert.Equal(t, "FAIL Case00: interface has no methods", have)
+	})
+}
+
+func Test_Mocker_GenerateManifest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		man := Manifest{
+			Mocks: []ManifestEntry{
+				{
+					Src:        "testdata/cases",
+					Tgt:        mod.Dir,
+					Interfaces: []string{"Case00", "Case54"},
+				},
+				{
+					Src:        "github.com/ctx42/testing/pkg/mocker/testdata/cases",
+					Tgt:        mod.Dir,
+					Interfaces: []string{"Case01"},
+					Name:       "MyMock",
+					Filename:   "my.go",
+				},
+			},
+		}
+		mck := New()
+
+		// --- When ---
+		have, err := mck.GenerateManifest(man)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := []Summary{
+			{
+				Interface: "Case00",
+				Src:       "testdata/cases",
+				Mock:      "Case00Mock",
+				Filename:  mod.Path("case00_mock.go"),
+				Written:   true,
+			},
+			{
+				Interface: "Case54",
+				Src:       "testdata/cases",
+				Mock:      "Case54Mock",
+				Filename:  mod.Path("case54_mock.go"),
+				Written:   true,
+			},
+			{
+				Interface: "Case01",
+				Src:       "github.com/ctx42/testing/pkg/mocker/testdata/cases",
+				Mock:      "MyMock",
+				Filename:  mod.Path("my.go"),
+				Written:   true,
+			},
+		}
+		assert.Equal(t, want, have)
+
+		assert.FileContain(t, "type Case00Mock struct", mod.Path("case00_mock.go"))
+		assert.FileContain(t, "type MyMock struct", mod.Path("my.go"))
+		gld := must.Value(os.ReadFile("testdata/generate_success.gld"))
+		_, content, _ := strings.Cut(string(gld), "---\n")
+		assert.FileContain(t, content, mod.Path("case54_mock.go"))
+
+		// The source package was resolved once.
+		var cnt int
+		for _, pkg := range mck.res.cache {
+			if pkg.pkgPath == "github.com/ctx42/testing/pkg/mocker/testdata/cases" {
+				cnt++
+			}
+		}
+		assert.Equal(t, 1, cnt)
+	})
+
+	t.Run("atomic when interface cannot be resolved", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		man := Manifest{
+			Mocks: []ManifestEntry{
+				{
+					Src:        "testdata/cases",
+					Tgt:        mod.Dir,
+					Interfaces: []string{"Case00", "Concrete", "Empty", "Case01"},
+				},
+			},
+		}
+
+		// --- When ---
+		have, err := New().GenerateManifest(man)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnkItf, err)
+		assert.ErrorIs(t, ErrNoMethods, err)
+		assert.ErrorContain(t, "Concrete: interface not found", err)
+		assert.ErrorContain(t, "Empty: interface has no methods", err)
+
+		assert.Len(t, 4, have)
+		assert.NoError(t, have[0].Err)
+		assert.False(t, have[0].Written)
+		assert.ErrorIs(t, ErrUnkItf, have[1].Err)
+		assert.ErrorIs(t, ErrNoMethods, have[2].Err)
+		assert.NoError(t, have[3].Err)
+		assert.False(t, have[3].Written)
+
+		assert.NoFileExist(t, mod.Path("case00_mock.go"))
+		assert.NoFileExist(t, mod.Path("case01_mock.go"))
+		assert.Len(t, 0, must.Value(filepath.Glob(mod.Path(".mocker-*"))))
+	})
+
+	t.Run("error - duplicate mock file", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		man := Manifest{
+			Mocks: []ManifestEntry{
+				{
+					Src:        "testdata/cases",
+					Tgt:        mod.Dir,
+					Interfaces: []string{"Case00"},
+					Filename:   "my.go",
+				},
+				{
+					Src:        "testdata/cases",
+					Tgt:        mod.Dir,
+					Interfaces: []string{"Case01"},
+					Filename:   "my.go",
+				},
+			},
+		}
+
+		// --- When ---
+		have, err := New().GenerateManifest(man)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrManifest, err)
+		assert.ErrorContain(t, "Case01: invalid manifest: duplicate mock file", err)
+		assert.Len(t, 2, have)
+		assert.NoFileExist(t, mod.Path("my.go"))
+	})
+
+	t.Run("error - invalid manifest", func(t *testing.T) {
+		// --- When ---
+		have, err := New().GenerateManifest(Manifest{})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrManifest, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Mocker_CheckManifest(t *testing.T) {
+	t.Run("up to date", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		man := Manifest{
+			Mocks: []ManifestEntry{
+				{
+					Src:        "testdata/cases",
+					Tgt:        mod.Dir,
+					Interfaces: []string{"Case00", "Case54"},
+				},
+			},
+		}
+		mck := New()
+		must.Value(mck.GenerateManifest(man))
+
+		// --- When ---
+		have, err := mck.CheckManifest(man)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := []Summary{
+			{
+				Interface: "Case00",
+				Src:       "testdata/cases",
+				Mock:      "Case00Mock",
+				Filename:  mod.Path("case00_mock.go"),
+				Checked:   true,
+			},
+			{
+				Interface: "Case54",
+				Src:       "testdata/cases",
+				Mock:      "Case54Mock",
+				Filename:  mod.Path("case54_mock.go"),
+				Checked:   true,
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("out of date and missing", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		man := Manifest{
+			Mocks: []ManifestEntry{
+				{
+					Src:        "testdata/cases",
+					Tgt:        mod.Dir,
+					Interfaces: []string{"Case00", "Case01", "Case54"},
+				},
+			},
+		}
+		mck := New()
+		must.Value(mck.GenerateManifest(man))
+		must.Nil(os.WriteFile(mod.Path("case00_mock.go"), []byte("old"), 0644))
+		must.Nil(os.Remove(mod.Path("case54_mock.go")))
+
+		// --- When ---
+		have, err := mck.CheckManifest(man)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrStale, err)
+		assert.ErrorContain(t, "Case00: mock is out of date: ", err)
+		assert.ErrorContain(t, "\n-old\n", err)
+		assert.ErrorContain(t, "Case54: mock is out of date: ", err)
+
+		assert.Len(t, 3, have)
+		assert.ErrorIs(t, ErrStale, have[0].Err)
+		assert.False(t, have[0].Checked)
+		assert.NoError(t, have[1].Err)
+		assert.True(t, have[1].Checked)
+		assert.ErrorIs(t, ErrStale, have[2].Err)
+		assert.False(t, have[2].Checked)
+
+		assert.FileContain(t, "old", mod.Path("case00_mock.go"))
+		assert.NoFileExist(t, mod.Path("case54_mock.go"))
+	})
+
+	t.Run("error - interface cannot be resolved", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		man := Manifest{
+			Mocks: []ManifestEntry{
+				{
+					Src:        "testdata/cases",
+					Tgt:        mod.Dir,
+					Interfaces: []string{"Concrete"},
+				},
+			},
+		}
+
+		// --- When ---
+		have, err := New().CheckManifest(man)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnkItf, err)
+		assert.ErrorIsNot(t, ErrStale, err)
+		assert.Len(t, 1, have)
+		assert.ErrorIs(t, ErrUnkItf, have[0].Err)
+	})
+
+	t.Run("error - invalid manifest", func(t *testing.T) {
+		// --- When ---
+		have, err := New().CheckManifest(Manifest{})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrManifest, err)
+		assert.Nil(t, have)
+	})
+}
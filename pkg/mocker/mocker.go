@@ -13,6 +13,9 @@
 // Key entry points:
 //   - [Generate] (convenience wrapper)
 //   - [New] + [Mocker.Generate] (full control)
+//   - [ReadManifest] + [Mocker.GenerateManifest] (batch generation)
+//   - [Check], [Mocker.Check] and [Mocker.CheckManifest] (stale mock
+//     detection)
 //   - Option functions: [WithSrc], [WithTgt], [WithTgtName],
 //     [WithTgtOutput], etc.
 package mocker
@@ -22,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
+	"go/token"
 	"io"
 	"strings"
 )
@@ -37,6 +41,15 @@ func Generate(name string, opts ...Option) error {
 	return New().Generate(name, opts...)
 }
 
+// Check generates in memory a mock implementation for the specified interface
+// name and compares it with the mock file on disk. Returns an error wrapping
+// [ErrStale] when the file is missing or out of date.
+//
+// For more control use [New] + [Mocker.Check].
+func Check(name string, opts ...Option) error {
+	return New().Check(name, opts...)
+}
+
 // Sentinel errors.
 var (
 	// ErrUnkPkg is returned when a directory or an import path does not point
@@ -61,6 +74,13 @@ var (
 
 	// ErrNoMethods is returned when the interface to mock has no methods.
 	ErrNoMethods = errors.New("interface has no methods")
+
+	// ErrManifest is returned when the manifest is invalid.
+	ErrManifest = errors.New("invalid manifest")
+
+	// ErrStale is returned in check mode when the mock file is missing or its
+	// content differs from the generated mock.
+	ErrStale = errors.New("mock is out of date")
 )
 
 // Mocker is the main type for generating interface mocks.
@@ -82,7 +102,7 @@ func New() *Mocker { return &Mocker{res: &resolver{}} }
 // See the package [README] and [examples_test.go] for detailed usage and
 // configuration options.
 func (mck *Mocker) Generate(name string, opts ...Option) error {
-	cfg, err := newConfig(name, opts...)
+	cfg, err := mck.config(name, opts...)
 	if err != nil {
 		return err
 	}
@@ -91,6 +111,42 @@ func (mck *Mocker) Generate(name string, opts ...Option) error {
 	if err != nil {
 		return err
 	}
+	return mck.generate(cfg, created)
+}
+
+// Check generates in memory a mock implementation for the specified interface
+// name and compares it with the mock file on disk. Nothing is written.
+//
+// Returns nil when the mock file is up to date. When the file is missing or
+// out of date, returns an error wrapping [ErrStale] with the unified diff
+// between the file and the generated mock. The [WithTgtOutput] option cannot
+// be used in check mode.
+func (mck *Mocker) Check(name string, opts ...Option) error {
+	cfg, err := mck.config(name, opts...)
+	if err != nil {
+		return err
+	}
+	if cfg.tgtOut != nil {
+		return errors.New("cannot use WithTgtOutput option in check mode")
+	}
+	out, err := mck.generateOutput(cfg)
+	if err != nil {
+		return err
+	}
+	return out.check()
+}
+
+// config creates a validated configuration for mocking the given interface
+// using the [Mocker] package cache.
+func (mck *Mocker) config(name string, opts ...Option) (Config, error) {
+	opts = append([]Option{withResolver(mck.res)}, opts...)
+	return newConfig(name, opts...)
+}
+
+// generate generates the mock code and writes it to the configured output.
+// When the output was created by [Config.create], the package clause has
+// already been written to it.
+func (mck *Mocker) generate(cfg Config, created bool) error {
 	itf, err := mck.run(cfg)
 	if err != nil {
 		return err
@@ -114,12 +170,14 @@ func (mck *Mocker) Generate(name string, opts ...Option) error {
 
 	buf.WriteString(genImports(imps))
 	buf.WriteString("\n\n")
-	const format = "type %s struct {\n\t*mock.Mock\n\tt %s.T\n}"
-	_, _ = fmt.Fprintf(buf, format, cfg.tgtName, tstImp.pkgName)
+	tParams, tArgs := itf.genTypeParams(), itf.genTypeArgs()
+	const format = "type %s%s struct {\n\t*mock.Mock\n\tt %s.T\n}"
+	_, _ = fmt.Fprintf(buf, format, cfg.tgtName, tParams, tstImp.pkgName)
 	buf.WriteString("\n\n")
-	buf.WriteString(mck.genConstructor(cfg.tgtName, tstImp.pkgName))
+	code := mck.genConstructor(cfg.tgtName, tParams, tArgs, tstImp.pkgName)
+	buf.WriteString(code)
 	buf.WriteString("\n\n")
-	buf.WriteString(itf.generate(cfg.tgtName, cfg.onHelpers))
+	buf.WriteString(itf.generate(cfg.tgtName+tArgs, cfg.onHelpers))
 	buf.WriteString("\n")
 	if _, err = buf.WriteTo(cfg.tgtOut); err != nil {
 		return err
@@ -133,12 +191,17 @@ func (mck *Mocker) Generate(name string, opts ...Option) error {
 // run runs mocker for a given configuration without generating code for the
 // mock.
 func (mck *Mocker) run(cfg Config) (*goitf, error) {
-	fil, astItf, err := cfg.srcPkg.findItf(cfg.srcName)
+	fil, typ, err := cfg.srcPkg.findItf(cfg.srcName)
 	if err != nil {
 		return nil, err
 	}
 	cfg.srcFile = fil
-	cfg.srcItf = astItf
+	cfg.srcItf = typ.Type.(*ast.InterfaceType) // nolint: forcetypeassert
+
+	tps, err := mck.typeParams(&cfg, typ.TypeParams)
+	if err != nil {
+		return nil, err
+	}
 
 	mts, err := mck.methods(cfg)
 	if err != nil {
@@ -146,11 +209,49 @@ func (mck *Mocker) run(cfg Config) (*goitf, error) {
 	}
 	itf := &goitf{
 		name:    cfg.srcName,
+		tparams: tps,
 		methods: mts,
 	}
 	return itf, nil
 }
 
+// typeParams maps interface type parameters to the types they stand for and
+// stores the mapping in the configuration. When the configuration has type
+// arguments (embedded generic interface), the type parameters stand for them,
+// otherwise they stand for themselves, and the type parameters with parsed
+// constraints are returned.
+func (mck *Mocker) typeParams(cfg *Config, lst *ast.FieldList) ([]argument, error) {
+	var names []string
+	if lst != nil {
+		for _, fld := range lst.List {
+			for _, ident := range fld.Names {
+				names = append(names, ident.Name)
+			}
+		}
+	}
+
+	tArgs := cfg.srcTArgs
+	if tArgs != nil && len(tArgs) != len(names) {
+		format := "%w: wrong number of type arguments for %s"
+		return nil, fmt.Errorf(format, ErrAstParse, cfg.srcName)
+	}
+
+	cfg.srcTArgs = nil
+	cfg.srcTParams = make(map[string]expression, len(names))
+	for i, name := range names {
+		if tArgs != nil {
+			cfg.srcTParams[name] = tArgs[i]
+		} else {
+			cfg.srcTParams[name] = expression{value: name}
+		}
+	}
+
+	if tArgs != nil || len(names) == 0 {
+		return nil, nil
+	}
+	return mck.parseArgs(*cfg, lst.List)
+}
+
 // methods parses code for source interface methods.
 func (mck *Mocker) methods(cfg Config) ([]*method, error) {
 	fls := cfg.srcItf.Methods.List
@@ -219,11 +320,39 @@ func (mck *Mocker) parseItf(cfg Config, fld *ast.Field) ([]*method, error) {
 			return nil, err
 		}
 		return itf.methods, nil
+
+	// Embedded generic interface with a single type argument.
+	case *ast.IndexExpr:
+		return mck.parseGenericEmbed(cfg, v.X, v.Index)
+
+	// Embedded generic interface with multiple type arguments.
+	case *ast.IndexListExpr:
+		return mck.parseGenericEmbed(cfg, v.X, v.Indices...)
 	}
 
 	return nil, fmt.Errorf("unexpected method field type: %T", fld.Type)
 }
 
+// parseGenericEmbed parses code for an embedded generic interface "x"
+// instantiated with the "idx" type arguments.
+func (mck *Mocker) parseGenericEmbed(
+	cfg Config,
+	x ast.Expr,
+	idx ...ast.Expr,
+) ([]*method, error) {
+
+	tArgs := make([]expression, 0, len(idx))
+	for _, e := range idx {
+		exp, err := mck.parseExpr(cfg, e)
+		if err != nil {
+			return nil, err
+		}
+		tArgs = append(tArgs, exp)
+	}
+	cfg.srcTArgs = tArgs
+	return mck.parseItf(cfg, &ast.Field{Type: x})
+}
+
 // parseFunc parses interface method.
 func (mck *Mocker) parseFunc(cfg Config, fn *ast.FuncType) (*method, error) {
 	var err error
@@ -276,6 +405,11 @@ func (mck *Mocker) parseExpr(cfg Config, e ast.Expr) (expression, error) {
 	switch v := e.(type) {
 	// Local type (the same package) from a potentially different file.
 	case *ast.Ident:
+		// Interface type parameter.
+		if exp, ok := cfg.srcTParams[v.Name]; ok {
+			return exp, nil
+		}
+
 		if _, _, err := cfg.srcPkg.findType(v.Name); err == nil {
 			exp := expression{}
 			if cfg.srcPkg.pkgPath != cfg.tgtPkg.pkgPath {
@@ -446,15 +580,51 @@ func (mck *Mocker) parseExpr(cfg Config, e ast.Expr) (expression, error) {
 		if v.Methods != nil && len(v.Methods.List) == 0 {
 			return expression{value: "any"}, nil
 		}
+
+	// Type parameter constraint term, like: ~int.
+	case *ast.UnaryExpr:
+		if v.Op != token.TILDE {
+			break
+		}
+		got, err := mck.parseExpr(cfg, v.X)
+		if err != nil {
+			return expression{}, err
+		}
+		got.value = "~" + got.value
+		return got, nil
+
+	// Type parameter constraint union, like: ~int | ~string.
+	case *ast.BinaryExpr:
+		if v.Op != token.OR {
+			break
+		}
+		gotX, err := mck.parseExpr(cfg, v.X)
+		if err != nil {
+			return expression{}, err
+		}
+		gotY, err := mck.parseExpr(cfg, v.Y)
+		if err != nil {
+			return expression{}, err
+		}
+		gotX.value += " | " + gotY.value
+		gotX.pks = append(gotX.pks, gotY.pks...)
+		return gotX, nil
 	}
 	return expression{}, ErrAstParse
 }
 
-// genConstructor generates code for the mock constructor.
-func (mck *Mocker) genConstructor(typeName, testerName string) string {
-	const format = "func New%[1]s(t %s.T) *%[1]s {\n" +
+// genConstructor generates code for the mock constructor. For generic mocks,
+// the tParams is the type parameter list and tArgs are the type parameter
+// names (see [goitf.genTypeParams] and [goitf.genTypeArgs]). The constructor
+// registers the mock instance as the owner of the [mock.Mock] (see
+// [mock.WithOwner]), so nice mocks can build zero return values.
+func (mck *Mocker) genConstructor(typeName, tParams, tArgs, testerName string) string {
+	const format = "func New%[1]s%[2]s(t %[4]s.T, opts ...mock.Option) *%[1]s%[3]s {\n" +
 		"\tt.Helper()\n" +
-		"\treturn &%[1]s{Mock: mock.NewMock(t), t: t}\n" +
+		"\t_mck := &%[1]s%[3]s{t: t}\n" +
+		"\topts = append([]mock.Option{mock.WithOwner(_mck)}, opts...)\n" +
+		"\t_mck.Mock = mock.NewMock(t, opts...)\n" +
+		"\treturn _mck\n" +
 		"}"
-	return fmt.Sprintf(format, typeName, testerName)
+	return fmt.Sprintf(format, typeName, tParams, tArgs, testerName)
 }
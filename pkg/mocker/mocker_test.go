@@ -156,6 +156,97 @@ func Test_Mocker_Generate(t *testing.T) {
 	})
 }
 
+func Test_Mocker_Check(t *testing.T) {
+	t.Run("up to date", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		opts := []Option{WithSrc("testdata/cases"), WithTgt(mod.Dir)}
+		mck := New()
+		must.Nil(mck.Generate("Case54", opts...))
+
+		// --- When ---
+		err := mck.Check("Case54", opts...)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("out of date", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		opts := []Option{WithSrc("testdata/cases"), WithTgt(mod.Dir)}
+		mck := New()
+		must.Nil(mck.Generate("Case54", opts...))
+		pth := mod.Path("case54_mock.go")
+		content := must.Value(os.ReadFile(pth))
+		content = bytes.Replace(content, []byte("Method54"), []byte("Old54"), 1)
+		must.Nil(os.WriteFile(pth, content, 0644))
+
+		// --- When ---
+		err := mck.Check("Case54", opts...)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrStale, err)
+		assert.ErrorContain(t, "mock is out of date: "+pth+"\n", err)
+		assert.ErrorContain(t, "--- "+pth+"\n+++ generated\n", err)
+		assert.ErrorContain(t, "\n-func (_mck *Case54Mock) Old54(", err)
+		assert.ErrorContain(t, "\n+func (_mck *Case54Mock) Method54(", err)
+		assert.Equal(t, string(content), string(must.Value(os.ReadFile(pth))))
+	})
+
+	t.Run("mock file does not exist", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		opts := []Option{WithSrc("testdata/cases"), WithTgt(mod.Dir)}
+
+		// --- When ---
+		err := New().Check("Case54", opts...)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrStale, err)
+		wMsg := "mock is out of date: " + mod.Path("case54_mock.go") +
+			" does not exist"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.NoFileExist(t, mod.Path("case54_mock.go"))
+	})
+
+	t.Run("error - configuration", func(t *testing.T) {
+		// --- When ---
+		err := New().Check("")
+
+		// --- Then ---
+		assert.ErrorEqual(t, "interface name is required for mocking", err)
+	})
+
+	t.Run("error - custom output", func(t *testing.T) {
+		// --- Given ---
+		opts := []Option{
+			WithTgtOutput(&bytes.Buffer{}),
+			WithSrc("testdata/cases"),
+		}
+
+		// --- When ---
+		err := New().Check("Case54", opts...)
+
+		// --- Then ---
+		wMsg := "cannot use WithTgtOutput option in check mode"
+		assert.ErrorEqual(t, wMsg, err)
+	})
+
+	t.Run("error - empty interface", func(t *testing.T) {
+		// --- Given ---
+		mod := tstmod.New(t, "v2")
+		opts := []Option{WithSrc("testdata/cases"), WithTgt(mod.Dir)}
+
+		// --- When ---
+		err := New().Check("Empty", opts...)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNoMethods, err)
+		assert.ErrorIsNot(t, ErrStale, err)
+	})
+}
+
 func Test_Mocker_Generate_tabular(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -233,6 +324,16 @@ func Test_Mocker_Generate_tabular(t *testing.T) {
 		{"Case60", "Case60", "cases", "golden"},
 		{"Case61", "Case61", "cases", "golden"},
 		{"Case61_dst_cases", "Case61", "cases", "cases"},
+		{"Case62", "Case62", "cases", "golden"},
+		{"Case63", "Case63", "cases", "golden"},
+		{"Case64", "Case64", "cases", "golden"},
+		{"Case65", "Case65", "cases", "golden"},
+		{"Case66", "Case66", "cases", "golden"},
+		{"Case66_dst_cases", "Case66", "cases", "cases"},
+		{"Case67", "Case67", "cases", "golden"},
+		{"Case68", "Case68", "cases", "golden"},
+		{"Case69", "Case69", "cases", "golden"},
+		{"Case70", "Case70", "cases", "golden"},
 
 		{"ItfA", "ItfA", "cases", "golden"},
 		{"ItfB", "ItfB", "cases", "golden"},
@@ -268,3 +369,46 @@ func Test_Mocker_Generate_tabular(t *testing.T) {
 		})
 	}
 }
+
+func Test_Mocker_Generate_on_helpers_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		itfName string
+	}{
+		{"Case62_on", "Case62"},
+		{"Case63_on", "Case63"},
+		{"Case65_on", "Case65"},
+		{"Case66_on", "Case66"},
+		{"Case68_on", "Case68"},
+		{"Case69_on", "Case69"},
+		{"Case70_on", "Case70"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			buf := &bytes.Buffer{}
+			impPath := "github.com/ctx42/testing/pkg/mocker/testdata/"
+			opts := []Option{
+				WithSrc(impPath + "cases"),
+				WithTgt(impPath + "golden"),
+				WithTgtName(tc.itfName),
+				WithTgtOutput(buf),
+				WithTgtOnHelpers,
+			}
+
+			// --- When ---
+			err := New().Generate(tc.itfName, opts...)
+
+			// --- Then ---
+			assert.NoError(t, err)
+
+			gfp := filepath.Join("testdata/golden", tc.testN+".gld")
+			want := goldy.Open(t, gfp)
+			// nolint: gocritic
+			// want.SetContent(buf.String()).Save()
+			assert.Equal(t, want.String(), buf.String())
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package mocker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ctx42/testing/internal/diff"
+)
+
+// output represents the generated mock file.
+type output struct {
+	pth     string // Path to the mock file.
+	content []byte // Mock file content.
+}
+
+// generateOutput generates in memory the mock for the given configuration.
+// The configuration must not have the output set.
+func (mck *Mocker) generateOutput(cfg Config) (output, error) {
+	buf := &bytes.Buffer{}
+	cfg.tgtOut = buf
+	if err := mck.generate(cfg, false); err != nil {
+		return output{}, err
+	}
+	return output{pth: cfg.tgtFilename, content: buf.Bytes()}, nil
+}
+
+// check compares the generated mock with the mock file. When they differ,
+// it returns an error wrapping [ErrStale] with the unified diff between the
+// file and the generated mock.
+func (out output) check() error {
+	have, err := os.ReadFile(out.pth)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%w: %s does not exist", ErrStale, out.pth)
+		}
+		return err
+	}
+	if bytes.Equal(have, out.content) {
+		return nil
+	}
+	dif := diff.Unified(out.pth, "generated", string(have), string(out.content))
+	return fmt.Errorf("%w: %s\n%s", ErrStale, out.pth, dif)
+}
+
+// writeOutputs writes the generated mock files. The files are first written
+// to temporary files in the target directories, and renamed to their final
+// paths only when all of them were written successfully. When replacing any
+// of the files fails, the files already replaced are restored, so the mock
+// files are either all updated or none of them is.
+func writeOutputs(outs []output) error {
+	tmps := make([]string, 0, len(outs))
+	cleanup := func() {
+		for _, tmp := range tmps {
+			_ = os.Remove(tmp)
+		}
+	}
+
+	for _, out := range outs {
+		fil, err := os.CreateTemp(filepath.Dir(out.pth), ".mocker-*.tmp")
+		if err != nil {
+			cleanup()
+			return err
+		}
+		tmps = append(tmps, fil.Name())
+		_, err = fil.Write(out.content)
+		if cErr := fil.Close(); err == nil {
+			err = cErr
+		}
+		if err == nil {
+			err = os.Chmod(fil.Name(), 0644) // nolint: gosec
+		}
+		if err != nil {
+			cleanup()
+			return err
+		}
+	}
+
+	// Paths to the backups of the replaced files. Empty for new files.
+	baks := make([]string, len(outs))
+	restore := func(cnt int) {
+		for i := cnt - 1; i >= 0; i-- {
+			if baks[i] == "" {
+				_ = os.Remove(outs[i].pth)
+				continue
+			}
+			_ = os.Rename(baks[i], outs[i].pth)
+		}
+	}
+
+	for i, out := range outs {
+		bak, err := backup(out.pth)
+		if err != nil {
+			restore(i) // The file at out.pth was not moved.
+			cleanup()
+			return err
+		}
+		baks[i] = bak
+		if err = os.Rename(tmps[i], out.pth); err != nil {
+			restore(i + 1)
+			cleanup()
+			return err
+		}
+	}
+
+	for _, bak := range baks {
+		if bak != "" {
+			_ = os.Remove(bak)
+		}
+	}
+	return nil
+}
+
+// backup moves the existing file at pth to a temporary file in the same
+// directory and returns the temporary file path. Returns an empty string when
+// the file at pth doesn't exist.
+func backup(pth string) (string, error) {
+	if _, err := os.Lstat(pth); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	fil, err := os.CreateTemp(filepath.Dir(pth), ".mocker-*.bak")
+	if err != nil {
+		return "", err
+	}
+	bak := fil.Name()
+	_ = fil.Close()
+	if err = os.Rename(pth, bak); err != nil {
+		_ = os.Remove(bak)
+		return "", err
+	}
+	return bak, nil
+}
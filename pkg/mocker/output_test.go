@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package mocker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_output_check(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "a.go")
+		must.Nil(os.WriteFile(pth, []byte("a\nb\n"), 0644))
+		out := output{pth: pth, content: []byte("a\nb\n")}
+
+		// --- When ---
+		err := out.check()
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("different", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "a.go")
+		must.Nil(os.WriteFile(pth, []byte("a\nb\n"), 0644))
+		out := output{pth: pth, content: []byte("a\nc\n")}
+
+		// --- When ---
+		err := out.check()
+
+		// --- Then ---
+		wMsg := "mock is out of date: " + pth + "\n" +
+			"--- " + pth + "\n" +
+			"+++ generated\n" +
+			"@@ -1,2 +1,2 @@\n" +
+			" a\n" +
+			"-b\n" +
+			"+c\n"
+		assert.ErrorIs(t, ErrStale, err)
+		assert.ErrorEqual(t, wMsg, err)
+	})
+
+	t.Run("file does not exist", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "a.go")
+		out := output{pth: pth, content: []byte("a")}
+
+		// --- When ---
+		err := out.check()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrStale, err)
+		assert.ErrorEqual(t, "mock is out of date: "+pth+" does not exist", err)
+	})
+
+	t.Run("error - reading file", func(t *testing.T) {
+		// --- Given ---
+		out := output{pth: t.TempDir(), content: []byte("a")}
+
+		// --- When ---
+		err := out.check()
+
+		// --- Then ---
+		assert.Error(t, err)
+		assert.ErrorIsNot(t, ErrStale, err)
+	})
+}
+
+func Test_writeOutputs(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		outs := []output{
+			{pth: filepath.Join(dir, "a.go"), content: []byte("a")},
+			{pth: filepath.Join(dir, "b.go"), content: []byte("b")},
+		}
+
+		// --- When ---
+		err := writeOutputs(outs)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "a", string(must.Value(os.ReadFile(outs[0].pth))))
+		assert.Equal(t, "b", string(must.Value(os.ReadFile(outs[1].pth))))
+		fi := must.Value(os.Stat(outs[0].pth))
+		assert.Equal(t, os.FileMode(0644), fi.Mode().Perm())
+	})
+
+	t.Run("error - nothing written when any file fails", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		outs := []output{
+			{pth: filepath.Join(dir, "a.go"), content: []byte("a")},
+			{pth: filepath.Join(dir, "missing/b.go"), content: []byte("b")},
+		}
+
+		// --- When ---
+		err := writeOutputs(outs)
+
+		// --- Then ---
+		assert.ErrorIs(t, os.ErrNotExist, err)
+		assert.Len(t, 0, must.Value(os.ReadDir(dir)))
+	})
+
+	t.Run("success - existing files replaced", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		pth := filepath.Join(dir, "a.go")
+		must.Nil(os.WriteFile(pth, []byte("old"), 0600))
+		outs := []output{{pth: pth, content: []byte("a")}}
+
+		// --- When ---
+		err := writeOutputs(outs)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "a", string(must.Value(os.ReadFile(pth))))
+		assert.Len(t, 1, must.Value(os.ReadDir(dir)))
+	})
+
+	t.Run("error - replaced files restored", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		aPth := filepath.Join(dir, "a.go")
+		must.Nil(os.WriteFile(aPth, []byte("old"), 0600))
+		bPth := filepath.Join(dir, "b.go")
+		cPth := filepath.Join(dir, "c.go")
+		must.Nil(os.MkdirAll(filepath.Join(cPth, "sub"), 0700))
+		outs := []output{
+			{pth: aPth, content: []byte("a")},
+			{pth: bPth, content: []byte("b")},
+			{pth: cPth, content: []byte("c")},
+		}
+
+		// --- When ---
+		err := writeOutputs(outs)
+
+		// --- Then ---
+		assert.Error(t, err)
+		assert.Equal(t, "old", string(must.Value(os.ReadFile(aPth))))
+		assert.NoFileExist(t, bPth)
+		assert.DirExist(t, cPth)
+		assert.Len(t, 2, must.Value(os.ReadDir(dir)))
+	})
+
+	t.Run("error - target kept when backup fails", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		aPth := filepath.Join(dir, "a.go")
+		must.Nil(os.WriteFile(aPth, []byte("old"), 0600))
+		bPth := filepath.Join(dir, "b.go")
+		must.Nil(os.Mkdir(bPth, 0700))
+		outs := []output{
+			{pth: aPth, content: []byte("a")},
+			{pth: bPth, content: []byte("b")},
+		}
+
+		// --- When ---
+		err := writeOutputs(outs)
+
+		// --- Then ---
+		assert.Error(t, err)
+		assert.Equal(t, "old", string(must.Value(os.ReadFile(aPth))))
+		assert.DirExist(t, bPth)
+		assert.Len(t, 2, must.Value(os.ReadDir(dir)))
+	})
+}
@@ -112,3 +112,40 @@ type Case58 interface {
 type Case59 interface{ Method59(...int) }
 type Case60 interface{ Method60(...interface{}) }
 type Case61 interface{ Method61(a ItfA) }
+
+type Case62[T any] interface{ Method62(id string) (T, error) }
+
+type Case63[K comparable, V any] interface {
+	Method63(k K) (V, bool)
+}
+
+type Case64[T fmt.Stringer] interface{ Method64(a ...T) []T }
+
+type Case65[T ~int | ~string] interface {
+	Method65(a T) map[T]pkga.A1
+}
+
+type Case66[T any] interface {
+	Case62[T]
+	Method66(a ParamOne[T]) Case62[T]
+}
+
+type Case67 interface {
+	Case62[*Concrete]
+	Method67()
+}
+
+type Case68[K any, V comparable] interface {
+	Case63[V, K]
+	Method68(fn func(K) V)
+}
+
+type Case69[T ~int | ~string] interface {
+	Case65[T]
+	Method69(a T) T
+}
+
+type Case70 interface {
+	Case65[int]
+	Method70(a int) error
+}
@@ -368,6 +368,21 @@ func (msg *Notice) Chain(prev *Notice) *Notice {
 	return msg
 }
 
+// Unchain removes msg from its chain (mutates msg and its neighbors) and
+// returns msg. The previous and the next notices are linked together.
+//
+// After the call: msg.Prev() == nil and msg.Next() == nil.
+func (msg *Notice) Unchain() *Notice {
+	if msg.prev != nil {
+		msg.prev.next = msg.next
+	}
+	if msg.next != nil {
+		msg.next.prev = msg.prev
+	}
+	msg.prev, msg.next = nil, nil
+	return msg
+}
+
 // Head returns the first notice in the chain (or self if it is the head).
 //
 // Follows the prev pointers to the start of the linked list.
@@ -405,6 +420,14 @@ func (msg *Notice) All() []*Notice {
 	return mgs
 }
 
+// tail returns the last notice in the chain (or self if it is the tail).
+func (msg *Notice) tail() *Notice {
+	for msg.next != nil {
+		msg = msg.next
+	}
+	return msg
+}
+
 // collect collects all the notices in the chain starting with the Head notice.
 func (msg *Notice) collect() []*Notice { return msg.All() }
 
@@ -414,6 +437,11 @@ func (msg *Notice) collect() []*Notice { return msg.All() }
 // [Notice.Chain]. The result can be walked with [Notice.Head],
 // [Notice.Next], [Notice.Prev] or collected with [Notice.All].
 //
+// When an argument is already a chain, the whole chain (from its head to its
+// tail) is linked, so no notices are lost when joining the results of
+// multiple joins. Arguments already belonging to the chain being built are
+// ignored.
+//
 // Returns the last non-nil notice in the chain (or nil). The returned
 // value implements [error] and supports [errors.Is]/[errors.As] through
 // the notices' wrapped errors.
@@ -428,11 +456,14 @@ func Join(ers ...error) error {
 			continue
 		}
 		ne := From(next)
-		if err == nil {
-			err = ne
-			continue
+		if err != nil {
+			head := ne.Head()
+			if head == err.Head() {
+				continue
+			}
+			head.Chain(err)
 		}
-		err = ne.Chain(err)
+		err = ne.tail()
 	}
 	if err == nil {
 		return nil
@@ -981,6 +981,67 @@ func Test_Notice_Chain(t *testing.T) {
 	affirm.Nil(t, msg1.next)
 }
 
+func Test_Notice_Unchain(t *testing.T) {
+	t.Run("not chained", func(t *testing.T) {
+		// --- Given ---
+		msg := New("header")
+
+		// --- When ---
+		have := msg.Unchain()
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg, have))
+		affirm.Nil(t, have.prev)
+		affirm.Nil(t, have.next)
+	})
+
+	t.Run("middle", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0")
+		msg1 := New("header1")
+		msg2 := New("header2")
+		_ = Join(msg0, msg1, msg2)
+
+		// --- When ---
+		have := msg1.Unchain()
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg1, have))
+		affirm.Nil(t, have.prev)
+		affirm.Nil(t, have.next)
+		affirm.Equal(t, true, core.Same(msg0.next, msg2))
+		affirm.Equal(t, true, core.Same(msg2.prev, msg0))
+	})
+
+	t.Run("head", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0")
+		msg1 := New("header1")
+		_ = Join(msg0, msg1)
+
+		// --- When ---
+		_ = msg0.Unchain()
+
+		// --- Then ---
+		affirm.Nil(t, msg0.next)
+		affirm.Nil(t, msg1.prev)
+	})
+
+	t.Run("tail", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0")
+		msg1 := New("header1")
+		_ = Join(msg0, msg1)
+
+		// --- When ---
+		_ = msg1.Unchain()
+
+		// --- Then ---
+		affirm.Nil(t, msg0.next)
+		affirm.Nil(t, msg1.prev)
+	})
+}
+
 func Test_Notice_Head(t *testing.T) {
 	t.Run("without parent", func(t *testing.T) {
 		// --- Given ---
@@ -1140,6 +1201,56 @@ func Test_Join(t *testing.T) {
 		affirm.Equal(t, true, core.Same(msg0.next, msg1))
 		affirm.Equal(t, true, core.Same(msg1.prev, msg0))
 	})
+
+	t.Run("join chains", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0")
+		msg1 := New("header1")
+		msg2 := New("header2")
+		msg3 := New("header3")
+		chain0 := Join(msg0, msg1)
+		chain1 := Join(msg2, msg3)
+
+		// --- When ---
+		have := Join(chain0, chain1)
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg3, have))
+		all := From(have).All()
+		affirm.Equal(t, 4, len(all))
+		affirm.Equal(t, true, core.Same(msg0, all[0]))
+		affirm.Equal(t, true, core.Same(msg1, all[1]))
+		affirm.Equal(t, true, core.Same(msg2, all[2]))
+		affirm.Equal(t, true, core.Same(msg3, all[3]))
+	})
+
+	t.Run("chain element not at the tail", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0")
+		msg1 := New("header1")
+		msg2 := New("header2")
+		_ = Join(msg1, msg2)
+
+		// --- When ---
+		have := Join(msg0, msg1)
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg2, have))
+		affirm.Equal(t, 3, len(From(have).All()))
+	})
+
+	t.Run("ignore notices already in the chain", func(t *testing.T) {
+		// --- Given ---
+		msg0 := New("header0")
+		msg1 := New("header1")
+
+		// --- When ---
+		have := Join(msg0, msg1, msg0, msg1)
+
+		// --- Then ---
+		affirm.Equal(t, true, core.Same(msg1, have))
+		affirm.Equal(t, 2, len(From(have).All()))
+	})
 }
 
 // Benchmarks for the notice error model and hot formatting paths.
@@ -162,6 +162,26 @@ type TRec struct {
 
 // /////////////////////////////////////////////////////////////////////////////
 
+// TEqual has the Equal method comparing only the ID field.
+type TEqual struct {
+	ID  int
+	Str string
+}
+
+func (typ TEqual) Equal(other TEqual) bool { return typ.ID == other.ID }
+
+// /////////////////////////////////////////////////////////////////////////////
+
+// TCmp has the Cmp method comparing only the ID field.
+type TCmp struct {
+	ID  int
+	Str string
+}
+
+func (typ *TCmp) Cmp(other *TCmp) int { return typ.ID - other.ID }
+
+// /////////////////////////////////////////////////////////////////////////////
+
 const (
 	TCBoolA       bool       = true
 	TCBoolB       bool       = true